@@ -0,0 +1,200 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Accumulator computes running column statistics of data which is added to it row by row
+// or in batches of rows. It uses Welford's online algorithm so the statistics of the data
+// can be computed without holding all of it in memory at once.
+// Accumulator is not safe for concurrent use.
+type Accumulator struct {
+	n    float64
+	mean []float64
+	min  []float64
+	max  []float64
+	// comoment is a matrix of sums of products of deviations from the mean
+	comoment *mat.SymDense
+	// buf is a buffer for matrix rows
+	buf []float64
+}
+
+// NewAccumulator creates a new Accumulator of data with cols columns and returns it.
+// It returns error if cols is not positive.
+func NewAccumulator(cols int) (*Accumulator, error) {
+	if cols <= 0 {
+		return nil, fmt.Errorf("%w: invalid number of columns: %d", ErrZeroSize, cols)
+	}
+	a := &Accumulator{
+		mean:     make([]float64, cols),
+		min:      make([]float64, cols),
+		max:      make([]float64, cols),
+		comoment: mat.NewSymDense(cols, nil),
+		buf:      make([]float64, cols),
+	}
+	for j := 0; j < cols; j++ {
+		a.min[j] = math.Inf(1)
+		a.max[j] = math.Inf(-1)
+	}
+	return a, nil
+}
+
+// AddRow adds a single row of data to the accumulator.
+// It returns error if the row length does not match the number of accumulator columns.
+func (a *Accumulator) AddRow(row []float64) error {
+	if len(row) != len(a.mean) {
+		return fmt.Errorf("%w: row length: %d, columns: %d", ErrDimMismatch, len(row), len(a.mean))
+	}
+
+	a.n++
+	d := a.buf
+	for j, x := range row {
+		d[j] = x - a.mean[j]
+		a.mean[j] += d[j] / a.n
+		a.min[j] = math.Min(a.min[j], x)
+		a.max[j] = math.Max(a.max[j], x)
+	}
+	// d * (x - newMean)^T == (n-1)/n * d * d^T
+	a.comoment.SymRankOne(a.comoment, (a.n-1)/a.n, mat.NewVecDense(len(d), d))
+
+	return nil
+}
+
+// AddRows adds all rows of matrix m to the accumulator.
+// The batch statistics are merged with the accumulated statistics in a single step.
+// It returns error if m is nil, has zero size or its number of columns does not match
+// the number of accumulator columns.
+func (a *Accumulator) AddRows(m mat.Matrix) error {
+	if err := validMatrix(m); err != nil {
+		return err
+	}
+	rows, cols := m.Dims()
+	if cols != len(a.mean) {
+		return &DimError{Expected: Shape{rows, len(a.mean)}, Actual: Shape{rows, cols}}
+	}
+
+	// batch mean, min and max
+	nb := float64(rows)
+	mean := make([]float64, cols)
+	for i := 0; i < rows; i++ {
+		row := mat.Row(a.buf, i, m)
+		for j, x := range row {
+			mean[j] += x
+			a.min[j] = math.Min(a.min[j], x)
+			a.max[j] = math.Max(a.max[j], x)
+		}
+	}
+	for j := range mean {
+		mean[j] /= nb
+	}
+
+	// batch comoment
+	x := mat.NewDense(rows, cols, nil)
+	for i := 0; i < rows; i++ {
+		row := mat.Row(a.buf, i, m)
+		for j := range row {
+			row[j] -= mean[j]
+		}
+		x.SetRow(i, row)
+	}
+	comoment := &mat.SymDense{}
+	comoment.SymOuterK(1.0, x.T())
+
+	// merge the batch into the accumulated statistics
+	n := a.n + nb
+	delta := make([]float64, cols)
+	for j := range delta {
+		delta[j] = mean[j] - a.mean[j]
+		a.mean[j] += delta[j] * nb / n
+	}
+	a.comoment.AddSym(a.comoment, comoment)
+	a.comoment.SymRankOne(a.comoment, a.n*nb/n, mat.NewVecDense(cols, delta))
+	a.n = n
+
+	return nil
+}
+
+// Count returns the number of accumulated rows.
+func (a *Accumulator) Count() int {
+	return int(a.n)
+}
+
+// Mean returns the running mean of each column.
+// It returns NaN values if no data has been accumulated.
+func (a *Accumulator) Mean() []float64 {
+	if a.n == 0 {
+		return nanSlice(len(a.mean))
+	}
+	return append([]float64(nil), a.mean...)
+}
+
+// Variance returns the running sample variance of each column.
+// It returns NaN values if fewer than two rows have been accumulated.
+func (a *Accumulator) Variance() []float64 {
+	if a.n < 2 {
+		return nanSlice(len(a.mean))
+	}
+	res := make([]float64, len(a.mean))
+	for j := range res {
+		res[j] = a.comoment.At(j, j) / (a.n - 1)
+	}
+	return res
+}
+
+// Stdev returns the running sample standard deviation of each column.
+// It returns NaN values if fewer than two rows have been accumulated.
+func (a *Accumulator) Stdev() []float64 {
+	res := a.Variance()
+	for j := range res {
+		res[j] = math.Sqrt(res[j])
+	}
+	return res
+}
+
+// Min returns the running min value of each column.
+// It returns NaN values if no data has been accumulated.
+func (a *Accumulator) Min() []float64 {
+	if a.n == 0 {
+		return nanSlice(len(a.min))
+	}
+	return append([]float64(nil), a.min...)
+}
+
+// Max returns the running max value of each column.
+// It returns NaN values if no data has been accumulated.
+func (a *Accumulator) Max() []float64 {
+	if a.n == 0 {
+		return nanSlice(len(a.max))
+	}
+	return append([]float64(nil), a.max...)
+}
+
+// Cov returns the running sample covariance matrix of the columns.
+// It matches CovDim of the accumulated rows with dim set to Rows.
+// It returns a matrix of NaN values if fewer than two rows have been accumulated.
+func (a *Accumulator) Cov() *mat.SymDense {
+	cols := len(a.mean)
+	cov := mat.NewSymDense(cols, nil)
+	if a.n < 2 {
+		for i := 0; i < cols; i++ {
+			for j := i; j < cols; j++ {
+				cov.SetSym(i, j, math.NaN())
+			}
+		}
+		return cov
+	}
+	cov.ScaleSym(1/(a.n-1), a.comoment)
+	return cov
+}
+
+// nanSlice returns a slice of n NaN values
+func nanSlice(n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = math.NaN()
+	}
+	return s
+}
@@ -0,0 +1,99 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func TestAccumulator(t *testing.T) {
+	assert := assert.New(t)
+
+	rows, cols := 20, 3
+	mx, err := NewDenseNormal(rows, cols, 5.0, 2.0, rand.NewSource(1))
+	assert.NoError(err)
+
+	expMean, _ := ColsMean(cols, mx)
+	expStdev, _ := ColsStdev(cols, mx)
+	expMin, _ := ColsMin(cols, mx)
+	expMax, _ := ColsMax(cols, mx)
+	expCov, err := CovDim(mx, Rows)
+	assert.NoError(err)
+	statCov := &mat.SymDense{}
+	stat.CovarianceMatrix(statCov, mx, nil)
+	assert.True(mat.EqualApprox(statCov, expCov, 1e-12))
+
+	check := func(a *Accumulator) {
+		assert.Equal(rows, a.Count())
+		assert.InDeltaSlice(expMean, a.Mean(), 1e-12)
+		assert.InDeltaSlice(expStdev, a.Stdev(), 1e-12)
+		assert.Equal(expMin, a.Min())
+		assert.Equal(expMax, a.Max())
+		assert.True(mat.EqualApprox(expCov, a.Cov(), 1e-12))
+	}
+
+	// row by row
+	a, err := NewAccumulator(cols)
+	assert.NoError(err)
+	for i := 0; i < rows; i++ {
+		assert.NoError(a.AddRow(mx.RawRowView(i)))
+	}
+	check(a)
+
+	// uneven batches
+	a, err = NewAccumulator(cols)
+	assert.NoError(err)
+	assert.NoError(a.AddRows(mx.Slice(0, 7, 0, cols)))
+	assert.NoError(a.AddRow(mx.RawRowView(7)))
+	assert.NoError(a.AddRows(mx.Slice(8, rows, 0, cols).T().T()))
+	check(a)
+
+	// single batch
+	a, err = NewAccumulator(cols)
+	assert.NoError(err)
+	assert.NoError(a.AddRows(mx))
+	check(a)
+}
+
+func TestAccumulatorEmpty(t *testing.T) {
+	assert := assert.New(t)
+
+	a, err := NewAccumulator(2)
+	assert.NoError(err)
+	assert.Equal(0, a.Count())
+	for _, v := range [][]float64{a.Mean(), a.Min(), a.Max(), a.Variance()} {
+		assert.True(math.IsNaN(v[0]) && math.IsNaN(v[1]))
+	}
+
+	assert.NoError(a.AddRow([]float64{1.0, 2.0}))
+	assert.Equal([]float64{1.0, 2.0}, a.Mean())
+	assert.True(math.IsNaN(a.Stdev()[0]))
+	assert.True(math.IsNaN(a.Cov().At(0, 1)))
+}
+
+func TestAccumulatorErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	a, err := NewAccumulator(0)
+	assert.Nil(a)
+	assert.True(errors.Is(err, ErrZeroSize))
+
+	a, err = NewAccumulator(2)
+	assert.NoError(err)
+
+	err = a.AddRow([]float64{1.0})
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	err = a.AddRows(mat.NewDense(2, 3, nil))
+	assert.Equal(&DimError{Expected: Shape{2, 2}, Actual: Shape{2, 3}}, err)
+
+	err = a.AddRows(nil)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	assert.Equal(0, a.Count())
+}
@@ -0,0 +1,86 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ArgMax returns a slice of indices of the max values of matrix rows or columns as selected by dim.
+// The i-th element of the returned slice is the index of the max value within the i-th row or column.
+// If several elements have the max value, the index of the first of them is returned.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func ArgMax(dim Dim, m mat.Matrix) ([]int, error) {
+	return withValidDimIdx(dim, m, floats.MaxIdx)
+}
+
+// ArgMin returns a slice of indices of the min values of matrix rows or columns as selected by dim.
+// The i-th element of the returned slice is the index of the min value within the i-th row or column.
+// If several elements have the min value, the index of the first of them is returned.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func ArgMin(dim Dim, m mat.Matrix) ([]int, error) {
+	return withValidDimIdx(dim, m, floats.MinIdx)
+}
+
+// TopK returns k largest values of matrix rows or columns as selected by dim and their indices.
+// The i-th element of both returned slices holds the values and their indices within the i-th
+// row or column sorted in decreasing order of the values. Equal values are ordered by their
+// indices and NaN values are ordered after all the other values.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or k is not positive
+// or exceeds the length of the matrix rows or columns.
+func TopK(dim Dim, k int, m mat.Matrix) ([][]float64, [][]int, error) {
+	n, err := dimLen(dim, m)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, cols := m.Dims()
+	size := cols
+	if dim == Cols {
+		size = rows
+	}
+	if k <= 0 || k > size {
+		return nil, nil, fmt.Errorf("invalid k: %d", k)
+	}
+
+	vals := make([][]float64, n)
+	idx := make([][]int, n)
+	view := dimView(dim, m)
+	order := make([]int, size)
+	for i := 0; i < n; i++ {
+		x := values(view(i))
+		for j := range order {
+			order[j] = j
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			va, vb := x[order[a]], x[order[b]]
+			return va > vb || (!math.IsNaN(va) && math.IsNaN(vb))
+		})
+		vals[i] = make([]float64, k)
+		idx[i] = make([]int, k)
+		for j := 0; j < k; j++ {
+			idx[i][j] = order[j]
+			vals[i][j] = x[order[j]]
+		}
+	}
+
+	return vals, idx, nil
+}
+
+// withValidDimIdx applies function fn to all matrix rows or columns as selected by dim
+// and collects the returned indices into a slice.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func withValidDimIdx(dim Dim, m mat.Matrix, fn func([]float64) int) ([]int, error) {
+	n, err := dimLen(dim, m)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]int, n)
+	view := dimView(dim, m)
+	for i := range res {
+		res[i] = fn(values(view(i)))
+	}
+	return res, nil
+}
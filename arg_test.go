@@ -0,0 +1,80 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestArgMaxMin(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1.2, 3.4, 3.4,
+		4.5, -6.7, 7.1,
+		8.9, 10.0, -2.3,
+	}
+	mx := mat.NewDense(3, 3, data)
+
+	idx, err := ArgMax(Rows, mx)
+	assert.NoError(err)
+	assert.Equal([]int{1, 2, 1}, idx)
+
+	idx, err = ArgMax(Cols, mx)
+	assert.NoError(err)
+	assert.Equal([]int{2, 2, 1}, idx)
+
+	idx, err = ArgMin(Rows, mx)
+	assert.NoError(err)
+	assert.Equal([]int{0, 1, 2}, idx)
+
+	idx, err = ArgMin(Cols, mx.T())
+	assert.NoError(err)
+	assert.Equal([]int{0, 1, 2}, idx)
+
+	idx, err = ArgMax(Dim(4), mx)
+	assert.Nil(idx)
+	assert.True(errors.Is(err, ErrInvalidDim))
+
+	idx, err = ArgMin(Rows, nil)
+	assert.Nil(idx)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
+
+func TestTopK(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1.2, 3.4, 3.4, 0.5,
+		4.5, math.NaN(), 7.1, 2.0,
+		8.9, 10.0, -2.3, 11.0,
+	}
+	mx := mat.NewDense(3, 4, data)
+
+	vals, idx, err := TopK(Rows, 2, mx)
+	assert.NoError(err)
+	assert.Equal([][]int{{1, 2}, {2, 0}, {3, 1}}, idx)
+	assert.Equal([][]float64{{3.4, 3.4}, {7.1, 4.5}, {11.0, 10.0}}, vals)
+
+	vals, idx, err = TopK(Cols, 3, mx)
+	assert.NoError(err)
+	assert.Equal([][]int{{2, 1, 0}, {2, 0, 1}, {1, 0, 2}, {2, 1, 0}}, idx)
+	assert.Equal([]float64{8.9, 4.5, 1.2}, vals[0])
+	assert.Equal(3.4, vals[1][1])
+	assert.True(math.IsNaN(vals[1][2]))
+
+	for _, k := range []int{0, 5} {
+		vals, idx, err = TopK(Rows, k, mx)
+		assert.Nil(vals)
+		assert.Nil(idx)
+		assert.Error(err)
+	}
+
+	vals, idx, err = TopK(Rows, 1, &mat.Dense{})
+	assert.Nil(vals)
+	assert.Nil(idx)
+	assert.True(errors.Is(err, ErrZeroSize))
+}
@@ -0,0 +1,153 @@
+package matrix
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// NewDenseValDiag returns a matrix with size n x n whose k-th diagonal elements are set to val.
+// The main diagonal is k = 0, super-diagonals have k > 0 and sub-diagonals have k < 0.
+// NewDenseValDiag fails if invalid matrix dimensions are requested or if |k| >= n.
+func NewDenseValDiag(n, k int, val float64) (*mat.Dense, error) {
+	return withValidDims(n, n, func() (*mat.Dense, error) {
+		if k <= -n || k >= n {
+			return nil, fmt.Errorf("invalid diagonal offset: %d", k)
+		}
+		diag := make([]float64, n-abs(k))
+		for i := range diag {
+			diag[i] = val
+		}
+		return NewDenseDiags(n, []int{k}, [][]float64{diag})
+	})
+}
+
+// NewDenseDiag returns a square matrix with elements of v placed on its k-th diagonal.
+// The main diagonal is k = 0, super-diagonals have k > 0 and sub-diagonals have k < 0.
+// The size of the returned matrix is len(v)+|k|.
+// NewDenseDiag fails if v is empty.
+func NewDenseDiag(v []float64, k int) (*mat.Dense, error) {
+	if len(v) == 0 {
+		return nil, fmt.Errorf("%w: invalid diagonal supplied: %v", ErrZeroSize, v)
+	}
+	return NewDenseDiags(len(v)+abs(k), []int{k}, [][]float64{v})
+}
+
+// NewDenseTridiag returns a tridiagonal matrix whose main diagonal is set to diag,
+// first sub-diagonal to sub and first super-diagonal to super.
+// NewDenseTridiag fails if diag is empty or if sub or super length is not len(diag)-1.
+func NewDenseTridiag(sub, diag, super []float64) (*mat.Dense, error) {
+	return NewDenseDiags(len(diag), []int{-1, 0, 1}, [][]float64{sub, diag, super})
+}
+
+// NewDenseDiags returns n x n matrix whose diagonals at offsets are set to diags.
+// The main diagonal is offset 0, super-diagonals have positive offsets and sub-diagonals
+// have negative offsets. The length of diags[i] must be n-|offsets[i]|.
+// NewDenseDiags fails if invalid matrix dimensions are requested or the diagonals are invalid.
+func NewDenseDiags(n int, offsets []int, diags [][]float64) (*mat.Dense, error) {
+	b, err := NewBandDenseDiags(n, offsets, diags)
+	if err != nil {
+		return nil, err
+	}
+	return mat.DenseCopyOf(b), nil
+}
+
+// NewBandDenseDiags returns n x n band matrix whose diagonals at offsets are set to diags.
+// The main diagonal is offset 0, super-diagonals have positive offsets and sub-diagonals
+// have negative offsets. The length of diags[i] must be n-|offsets[i]|.
+// The bandwidth of the returned matrix is determined by the smallest and largest offset.
+// NewBandDenseDiags fails if invalid matrix dimensions are requested or the diagonals are invalid.
+func NewBandDenseDiags(n int, offsets []int, diags [][]float64) (*mat.BandDense, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: invalid number of rows: %d", ErrZeroSize, n)
+	}
+	if len(offsets) != len(diags) {
+		return nil, fmt.Errorf("%w: diagonals count mismatch: Offsets: %d, Diagonals: %d", ErrDimMismatch, len(offsets), len(diags))
+	}
+
+	kl, ku := 0, 0
+	seen := make(map[int]bool)
+	for i, k := range offsets {
+		if k <= -n || k >= n {
+			return nil, fmt.Errorf("invalid diagonal offset: %d", k)
+		}
+		if seen[k] {
+			return nil, fmt.Errorf("repeated diagonal offset: %d", k)
+		}
+		seen[k] = true
+		if len(diags[i]) != n-abs(k) {
+			return nil, fmt.Errorf("%w: invalid diagonal %d length: %d", ErrDimMismatch, k, len(diags[i]))
+		}
+		if -k > kl {
+			kl = -k
+		}
+		if k > ku {
+			ku = k
+		}
+	}
+
+	m := mat.NewBandDense(n, n, kl, ku, nil)
+	for i, k := range offsets {
+		for j, v := range diags[i] {
+			if k >= 0 {
+				m.SetBand(j, j+k, v)
+			} else {
+				m.SetBand(j-k, j, v)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// NewDenseLaplacian1D returns n x n matrix of the 1-D discrete Laplacian i.e. the second order
+// central difference operator on n grid points with zero Dirichlet boundary conditions.
+// Its main diagonal is set to -2 and its first sub and super diagonals are set to 1.
+// NewDenseLaplacian1D fails if n is not positive.
+func NewDenseLaplacian1D(n int) (*mat.Dense, error) {
+	return withValidDims(n, n, func() (*mat.Dense, error) {
+		return laplacian1D(n), nil
+	})
+}
+
+// NewDenseLaplacian2D returns (nx*ny) x (nx*ny) matrix of the 2-D discrete Laplacian i.e. the
+// five point stencil operator on nx x ny grid with zero Dirichlet boundary conditions.
+// The grid points are ordered with x index varying fastest.
+// NewDenseLaplacian2D fails if either nx or ny is not positive.
+func NewDenseLaplacian2D(nx, ny int) (*mat.Dense, error) {
+	return withValidDims(nx, ny, func() (*mat.Dense, error) {
+		lx, ly := laplacian1D(nx), laplacian1D(ny)
+		ix, _ := NewDenseValIdentity(nx, 1.0)
+		iy, _ := NewDenseValIdentity(ny, 1.0)
+
+		// kron(Iy, Lx) + kron(Ly, Ix)
+		m, k := &mat.Dense{}, &mat.Dense{}
+		m.Kronecker(iy, lx)
+		k.Kronecker(ly, ix)
+		m.Add(m, k)
+
+		return m, nil
+	})
+}
+
+// laplacian1D returns n x n tridiagonal matrix with -2 on its main diagonal and 1 on its
+// first sub and super diagonals.
+func laplacian1D(n int) *mat.Dense {
+	m := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, -2.0)
+		if i > 0 {
+			m.Set(i, i-1, 1.0)
+			m.Set(i-1, i, 1.0)
+		}
+	}
+	return m
+}
+
+// abs returns absolute value of integer x
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
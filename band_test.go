@@ -0,0 +1,150 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestNewDenseValDiag(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDenseValDiag(3, 1, 2.0)
+	assert.NoError(err)
+	exp := mat.NewDense(3, 3, []float64{
+		0, 2, 0,
+		0, 0, 2,
+		0, 0, 0,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseValDiag(3, -2, 2.0)
+	assert.NoError(err)
+	exp = mat.NewDense(3, 3, []float64{
+		0, 0, 0,
+		0, 0, 0,
+		2, 0, 0,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	// main diagonal matches NewDenseValIdentity
+	m, err = NewDenseValDiag(3, 0, 2.0)
+	assert.NoError(err)
+	eye, _ := NewDenseValIdentity(3, 2.0)
+	assert.True(mat.Equal(eye, m))
+
+	m, err = NewDenseValDiag(3, 3, 2.0)
+	assert.Nil(m)
+	assert.Error(err)
+
+	m, err = NewDenseValDiag(0, 0, 2.0)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseDiag(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDenseDiag([]float64{1, 2}, -1)
+	assert.NoError(err)
+	exp := mat.NewDense(3, 3, []float64{
+		0, 0, 0,
+		1, 0, 0,
+		0, 2, 0,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseDiag(nil, 0)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseTridiag(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDenseTridiag([]float64{1, 2}, []float64{3, 4, 5}, []float64{6, 7})
+	assert.NoError(err)
+	exp := mat.NewDense(3, 3, []float64{
+		3, 6, 0,
+		1, 4, 7,
+		0, 2, 5,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseTridiag([]float64{1}, []float64{3, 4, 5}, []float64{6, 7})
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewBandDenseDiags(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := NewBandDenseDiags(4, []int{0, 2}, [][]float64{{1, 2, 3, 4}, {5, 6}})
+	assert.NoError(err)
+	kl, ku := b.Bandwidth()
+	assert.Equal(0, kl)
+	assert.Equal(2, ku)
+	exp := mat.NewDense(4, 4, []float64{
+		1, 0, 5, 0,
+		0, 2, 0, 6,
+		0, 0, 3, 0,
+		0, 0, 0, 4,
+	})
+	assert.True(mat.Equal(exp, b))
+
+	m, err := NewDenseDiags(4, []int{0, 2}, [][]float64{{1, 2, 3, 4}, {5, 6}})
+	assert.NoError(err)
+	assert.True(mat.Equal(exp, m))
+
+	tests := []struct {
+		n       int
+		offsets []int
+		diags   [][]float64
+	}{
+		{0, nil, nil},
+		{2, []int{0}, nil},
+		{2, []int{2}, [][]float64{{}}},
+		{2, []int{0, 0}, [][]float64{{1, 2}, {1, 2}}},
+		{2, []int{1}, [][]float64{{1, 2}}},
+	}
+
+	for _, tc := range tests {
+		b, err := NewBandDenseDiags(tc.n, tc.offsets, tc.diags)
+		assert.Nil(b)
+		assert.Error(err)
+	}
+}
+
+func TestNewDenseLaplacian(t *testing.T) {
+	assert := assert.New(t)
+
+	l1, err := NewDenseLaplacian1D(3)
+	assert.NoError(err)
+	exp := mat.NewDense(3, 3, []float64{
+		-2, 1, 0,
+		1, -2, 1,
+		0, 1, -2,
+	})
+	assert.True(mat.Equal(exp, l1))
+
+	l2, err := NewDenseLaplacian2D(3, 2)
+	assert.NoError(err)
+	exp = mat.NewDense(6, 6, []float64{
+		-4, 1, 0, 1, 0, 0,
+		1, -4, 1, 0, 1, 0,
+		0, 1, -4, 0, 0, 1,
+		1, 0, 0, -4, 1, 0,
+		0, 1, 0, 1, -4, 1,
+		0, 0, 1, 0, 1, -4,
+	})
+	assert.True(mat.Equal(exp, l2))
+
+	l1, err = NewDenseLaplacian1D(0)
+	assert.Nil(l1)
+	assert.Error(err)
+
+	l2, err = NewDenseLaplacian2D(3, 0)
+	assert.Nil(l2)
+	assert.Error(err)
+}
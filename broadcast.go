@@ -0,0 +1,200 @@
+package matrix
+
+import (
+	"fmt"
+	"reflect"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Broadcasting arithmetic below applies an elementwise operation to matrix a and matrix b.
+// Matrix b is broadcast across matrix a if it is a 1 x cols row vector, a rows x 1 column
+// vector or a 1 x 1 matrix holding a scalar, where rows x cols are the dimensions of a.
+// If b has the same dimensions as a the operation is applied elementwise.
+//
+// The Into variants store the result in dst which must either be empty, in which case it
+// is resized to the dimensions of a, or have the same dimensions as a. dst may be a or
+// share data with a or b, e.g. be transposed a, in which case the result is computed
+// in a temporary matrix and copied into dst.
+
+// Add returns a new matrix holding the sum of matrix a and matrix b broadcast across it.
+// It returns error if either of the matrices is nil, has zero size or b can not be broadcast across a.
+func Add(a, b mat.Matrix) (*mat.Dense, error) {
+	return binary(a, b, add)
+}
+
+// AddInto stores the sum of matrix a and matrix b broadcast across it in dst.
+// It returns error if any of the matrices is nil, a or b has zero size, b can not be broadcast
+// across a or dst dimensions do not match the dimensions of a.
+func AddInto(dst *mat.Dense, a, b mat.Matrix) error {
+	return broadcast(dst, a, b, add)
+}
+
+// Sub returns a new matrix holding the difference of matrix a and matrix b broadcast across it.
+// It returns error if either of the matrices is nil, has zero size or b can not be broadcast across a.
+func Sub(a, b mat.Matrix) (*mat.Dense, error) {
+	return binary(a, b, sub)
+}
+
+// SubInto stores the difference of matrix a and matrix b broadcast across it in dst.
+// It returns error if any of the matrices is nil, a or b has zero size, b can not be broadcast
+// across a or dst dimensions do not match the dimensions of a.
+func SubInto(dst *mat.Dense, a, b mat.Matrix) error {
+	return broadcast(dst, a, b, sub)
+}
+
+// Mul returns a new matrix holding the elementwise product of matrix a and matrix b broadcast across it.
+// It returns error if either of the matrices is nil, has zero size or b can not be broadcast across a.
+func Mul(a, b mat.Matrix) (*mat.Dense, error) {
+	return binary(a, b, mul)
+}
+
+// MulInto stores the elementwise product of matrix a and matrix b broadcast across it in dst.
+// It returns error if any of the matrices is nil, a or b has zero size, b can not be broadcast
+// across a or dst dimensions do not match the dimensions of a.
+func MulInto(dst *mat.Dense, a, b mat.Matrix) error {
+	return broadcast(dst, a, b, mul)
+}
+
+// Div returns a new matrix holding the elementwise quotient of matrix a and matrix b broadcast across it.
+// Division by zero follows IEEE 754 rules i.e. it yields signed infinity or NaN.
+// It returns error if either of the matrices is nil, has zero size or b can not be broadcast across a.
+func Div(a, b mat.Matrix) (*mat.Dense, error) {
+	return binary(a, b, div)
+}
+
+// DivInto stores the elementwise quotient of matrix a and matrix b broadcast across it in dst.
+// Division by zero follows IEEE 754 rules i.e. it yields signed infinity or NaN.
+// It returns error if any of the matrices is nil, a or b has zero size, b can not be broadcast
+// across a or dst dimensions do not match the dimensions of a.
+func DivInto(dst *mat.Dense, a, b mat.Matrix) error {
+	return broadcast(dst, a, b, div)
+}
+
+func add(x, y float64) float64 { return x + y }
+func sub(x, y float64) float64 { return x - y }
+func mul(x, y float64) float64 { return x * y }
+func div(x, y float64) float64 { return x / y }
+
+// binary applies op to a and b broadcast across it and returns the result in a new matrix
+func binary(a, b mat.Matrix, op func(x, y float64) float64) (*mat.Dense, error) {
+	dst := &mat.Dense{}
+	if err := broadcast(dst, a, b, op); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// broadcast applies op to a and b broadcast across it and stores the result in dst
+func broadcast(dst *mat.Dense, a, b mat.Matrix, op func(x, y float64) float64) error {
+	if dst == nil {
+		return fmt.Errorf("%w: %v", ErrNilMatrix, dst)
+	}
+	if err := validMatrix(a); err != nil {
+		return err
+	}
+	if err := validMatrix(b); err != nil {
+		return err
+	}
+
+	rows, cols := a.Dims()
+	br, bc := b.Dims()
+
+	// bRow returns the values of b broadcast across the i-th row of a.
+	// Broadcast values are copied up front so that dst may share data with b.
+	bbuf := make([]float64, cols)
+	var bRow func(i int) []float64
+	full := false
+	switch {
+	case br == rows && bc == cols:
+		full = true
+		bRow = func(i int) []float64 { return mat.Row(bbuf, i, b) }
+	case br == 1 && bc == cols:
+		mat.Row(bbuf, 0, b)
+		bRow = func(int) []float64 { return bbuf }
+	case br == rows && bc == 1:
+		col := mat.Col(nil, 0, b)
+		bRow = func(i int) []float64 {
+			for j := range bbuf {
+				bbuf[j] = col[i]
+			}
+			return bbuf
+		}
+	case br == 1 && bc == 1:
+		val := b.At(0, 0)
+		for j := range bbuf {
+			bbuf[j] = val
+		}
+		bRow = func(int) []float64 { return bbuf }
+	default:
+		return &DimError{Expected: Shape{rows, cols}, Actual: Shape{br, bc}}
+	}
+
+	if err := reuseAs(dst, rows, cols); err != nil {
+		return err
+	}
+
+	// rows of a or b read after writing into dst must not see the written values
+	res := dst
+	if aliased(dst, a) || (full && aliased(dst, b)) {
+		res = mat.NewDense(rows, cols, nil)
+	}
+
+	abuf := make([]float64, cols)
+	for i := 0; i < rows; i++ {
+		x, y := mat.Row(abuf, i, a), bRow(i)
+		out := res.RawRowView(i)
+		for j := range out {
+			out[j] = op(x[j], y[j])
+		}
+	}
+
+	if res != dst {
+		dst.Copy(res)
+	}
+
+	return nil
+}
+
+// reuseAs resizes dst to rows x cols if it is empty.
+// It returns error if non-empty dst dimensions are not rows x cols.
+func reuseAs(dst *mat.Dense, rows, cols int) error {
+	if dst.IsEmpty() {
+		dst.ReuseAs(rows, cols)
+	}
+	if r, c := dst.Dims(); r != rows || c != cols {
+		return &DimError{Expected: Shape{rows, cols}, Actual: Shape{r, c}}
+	}
+	return nil
+}
+
+// aliased returns true if matrix m shares backing data with dst and is not dst itself.
+// Writing into dst may then change elements of m which have not been read yet.
+func aliased(dst *mat.Dense, m mat.Matrix) bool {
+	if d, ok := m.(*mat.Dense); ok && d == dst {
+		return false
+	}
+	if t, ok := m.(mat.Untransposer); ok {
+		m = t.Untranspose()
+	}
+	var data []float64
+	switch r := m.(type) {
+	case mat.RawMatrixer:
+		data = r.RawMatrix().Data
+	case mat.RawVectorer:
+		data = r.RawVector().Data
+	default:
+		return false
+	}
+	return overlap(dst.RawMatrix().Data, data)
+}
+
+// overlap returns true if slices a and b share any elements
+func overlap(a, b []float64) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	const size = 8
+	pa, pb := reflect.ValueOf(a).Pointer(), reflect.ValueOf(b).Pointer()
+	return pa < pb+uintptr(len(b))*size && pb < pa+uintptr(len(a))*size
+}
@@ -0,0 +1,121 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestBroadcast(t *testing.T) {
+	assert := assert.New(t)
+
+	a := mat.NewDense(2, 3, []float64{
+		1.0, 2.0, 3.0,
+		4.0, 5.0, 6.0,
+	})
+	row := mat.NewDense(1, 3, []float64{1.0, 2.0, 4.0})
+	col := mat.NewVecDense(2, []float64{2.0, -1.0})
+	scalar := mat.NewDense(1, 1, []float64{2.0})
+
+	tests := []struct {
+		fn  func(a, b mat.Matrix) (*mat.Dense, error)
+		b   mat.Matrix
+		exp []float64
+	}{
+		{Add, row, []float64{2.0, 4.0, 7.0, 5.0, 7.0, 10.0}},
+		{Add, col, []float64{3.0, 4.0, 5.0, 3.0, 4.0, 5.0}},
+		{Add, scalar, []float64{3.0, 4.0, 5.0, 6.0, 7.0, 8.0}},
+		{Add, a, []float64{2.0, 4.0, 6.0, 8.0, 10.0, 12.0}},
+		{Sub, row, []float64{0.0, 0.0, -1.0, 3.0, 3.0, 2.0}},
+		{Sub, col, []float64{-1.0, 0.0, 1.0, 5.0, 6.0, 7.0}},
+		{Sub, a.T().T(), []float64{0.0, 0.0, 0.0, 0.0, 0.0, 0.0}},
+		{Mul, row, []float64{1.0, 4.0, 12.0, 4.0, 10.0, 24.0}},
+		{Mul, col, []float64{2.0, 4.0, 6.0, -4.0, -5.0, -6.0}},
+		{Div, row.T().T(), []float64{1.0, 1.0, 0.75, 4.0, 2.5, 1.5}},
+		{Div, scalar, []float64{0.5, 1.0, 1.5, 2.0, 2.5, 3.0}},
+	}
+
+	for _, tc := range tests {
+		m, err := tc.fn(a, tc.b)
+		assert.NoError(err)
+		assert.True(mat.EqualApprox(mat.NewDense(2, 3, tc.exp), m, 1e-12))
+	}
+
+	m, err := Div(a, mat.NewDense(1, 1, nil))
+	assert.NoError(err)
+	assert.True(math.IsInf(m.At(0, 0), 1))
+
+	m, err = Add(a, mat.NewDense(2, 2, nil))
+	assert.Nil(m)
+	assert.Equal(&DimError{Expected: Shape{2, 3}, Actual: Shape{2, 2}}, err)
+
+	m, err = Mul(a, mat.NewVecDense(3, nil))
+	assert.Nil(m)
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	m, err = Sub(nil, row)
+	assert.Nil(m)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	m, err = Sub(a, &mat.Dense{})
+	assert.Nil(m)
+	assert.True(errors.Is(err, ErrZeroSize))
+}
+
+func TestBroadcastInto(t *testing.T) {
+	assert := assert.New(t)
+
+	a := mat.NewDense(3, 2, []float64{
+		1.0, 2.0,
+		3.0, 4.0,
+		5.0, 6.0,
+	})
+
+	// centre and scale the columns in place
+	means, _ := ColsMean(2, a)
+	err := SubInto(a, a, mat.NewDense(1, 2, means))
+	assert.NoError(err)
+	sd, _ := ColsStdev(2, a)
+	err = DivInto(a, a, mat.NewDense(1, 2, sd))
+	assert.NoError(err)
+	exp := mat.NewDense(3, 2, []float64{-1.0, -1.0, 0.0, 0.0, 1.0, 1.0})
+	assert.True(mat.EqualApprox(exp, a, 1e-12))
+
+	// broadcast vector may share data with dst
+	err = AddInto(a, a, a.RowView(2).T())
+	assert.NoError(err)
+	exp = mat.NewDense(3, 2, []float64{0.0, 0.0, 1.0, 1.0, 2.0, 2.0})
+	assert.True(mat.EqualApprox(exp, a, 1e-12))
+
+	// transposed dst is read before it is overwritten
+	e := mat.NewDense(2, 2, []float64{1.0, 2.0, 3.0, 4.0})
+	err = AddInto(e, e.T(), mat.NewDense(1, 1, nil))
+	assert.NoError(err)
+	assert.Equal([]float64{1.0, 3.0, 2.0, 4.0}, e.RawMatrix().Data)
+
+	e = mat.NewDense(2, 2, []float64{1.0, 2.0, 3.0, 4.0})
+	err = SubInto(e, e, e.T())
+	assert.NoError(err)
+	assert.Equal([]float64{0.0, -1.0, 1.0, 0.0}, e.RawMatrix().Data)
+
+	// overlapping views of the same matrix
+	f := mat.NewDense(3, 2, []float64{1.0, 2.0, 3.0, 4.0, 5.0, 6.0})
+	err = AddInto(f.Slice(1, 3, 0, 2).(*mat.Dense), f.Slice(0, 2, 0, 2), mat.NewDense(1, 1, nil))
+	assert.NoError(err)
+	assert.Equal([]float64{1.0, 2.0, 1.0, 2.0, 3.0, 4.0}, f.RawMatrix().Data)
+
+	dst := &mat.Dense{}
+	err = MulInto(dst, a, mat.NewVecDense(3, []float64{1.0, 2.0, 3.0}))
+	assert.NoError(err)
+	exp = mat.NewDense(3, 2, []float64{0.0, 0.0, 2.0, 2.0, 6.0, 6.0})
+	assert.True(mat.EqualApprox(exp, dst, 1e-12))
+
+	err = AddInto(mat.NewDense(2, 2, nil), a, a)
+	assert.Equal(&DimError{Expected: Shape{3, 2}, Actual: Shape{2, 2}}, err)
+
+	err = AddInto(nil, a, a)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
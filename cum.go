@@ -0,0 +1,106 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// CumSum returns a matrix of cumulative sums of the elements of matrix rows or columns as selected by dim.
+// Element (i, j) of the returned matrix holds the sum of the elements of the j-th column up to i-th row
+// if dim is Cols, or the sum of the elements of the i-th row up to j-th column if dim is Rows.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func CumSum(dim Dim, m mat.Matrix) (*mat.Dense, error) {
+	return cumulative(dim, m, func(acc, x float64) float64 { return acc + x })
+}
+
+// CumProd returns a matrix of cumulative products of the elements of matrix rows or columns as selected by dim.
+// The elements are accumulated in the same way as in CumSum.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func CumProd(dim Dim, m mat.Matrix) (*mat.Dense, error) {
+	return cumulative(dim, m, func(acc, x float64) float64 { return acc * x })
+}
+
+// CumMax returns a matrix of cumulative maxima of the elements of matrix rows or columns as selected by dim.
+// The elements are accumulated in the same way as in CumSum. NaN values are propagated.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func CumMax(dim Dim, m mat.Matrix) (*mat.Dense, error) {
+	return cumulative(dim, m, math.Max)
+}
+
+// CumMin returns a matrix of cumulative minima of the elements of matrix rows or columns as selected by dim.
+// The elements are accumulated in the same way as in CumSum. NaN values are propagated.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func CumMin(dim Dim, m mat.Matrix) (*mat.Dense, error) {
+	return cumulative(dim, m, math.Min)
+}
+
+// Diff returns a matrix of n-th order discrete differences of the elements of matrix rows or columns
+// as selected by dim. The first order difference of the j-th column is m[i+1, j] - m[i, j] if dim
+// is Cols and the first order difference of the i-th row is m[i, j+1] - m[i, j] if dim is Rows.
+// Higher order differences are calculated by applying Diff recursively. The returned matrix has n fewer
+// rows than m if dim is Cols or n fewer columns than m if dim is Rows. If n is 0 a copy of m is returned.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or n is negative
+// or not smaller than the length of the matrix rows or columns.
+func Diff(dim Dim, n int, m mat.Matrix) (*mat.Dense, error) {
+	if _, err := dimLen(dim, m); err != nil {
+		return nil, err
+	}
+	rows, cols := m.Dims()
+	size := rows
+	if dim == Rows {
+		size = cols
+	}
+	if n < 0 || n >= size {
+		return nil, fmt.Errorf("invalid difference order: %d", n)
+	}
+
+	d := mat.DenseCopyOf(m)
+	for k := 0; k < n; k++ {
+		r, c := d.Dims()
+		if dim == Cols {
+			r--
+		} else {
+			c--
+		}
+		diff := mat.NewDense(r, c, nil)
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				if dim == Cols {
+					diff.Set(i, j, d.At(i+1, j)-d.At(i, j))
+				} else {
+					diff.Set(i, j, d.At(i, j+1)-d.At(i, j))
+				}
+			}
+		}
+		d = diff
+	}
+
+	return d, nil
+}
+
+// cumulative returns a copy of matrix m whose rows or columns as selected by dim
+// are accumulated by function fn which combines the accumulated value with the next element.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func cumulative(dim Dim, m mat.Matrix, fn func(acc, x float64) float64) (*mat.Dense, error) {
+	if _, err := dimLen(dim, m); err != nil {
+		return nil, err
+	}
+
+	d := mat.DenseCopyOf(m)
+	rows, cols := d.Dims()
+	raw := d.RawMatrix()
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			switch {
+			case dim == Cols && i > 0:
+				raw.Data[i*raw.Stride+j] = fn(raw.Data[(i-1)*raw.Stride+j], raw.Data[i*raw.Stride+j])
+			case dim == Rows && j > 0:
+				raw.Data[i*raw.Stride+j] = fn(raw.Data[i*raw.Stride+j-1], raw.Data[i*raw.Stride+j])
+			}
+		}
+	}
+
+	return d, nil
+}
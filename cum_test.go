@@ -0,0 +1,113 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCumulative(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1, 2, 3,
+		4, -5, 6,
+		-7, 8, 9,
+	}
+	mx := mat.NewDense(3, 3, data)
+
+	tests := []struct {
+		fn   func(Dim, mat.Matrix) (*mat.Dense, error)
+		dim  Dim
+		want []float64
+	}{
+		{CumSum, Cols, []float64{1, 2, 3, 5, -3, 9, -2, 5, 18}},
+		{CumSum, Rows, []float64{1, 3, 6, 4, -1, 5, -7, 1, 10}},
+		{CumProd, Cols, []float64{1, 2, 3, 4, -10, 18, -28, -80, 162}},
+		{CumProd, Rows, []float64{1, 2, 6, 4, -20, -120, -7, -56, -504}},
+		{CumMax, Cols, []float64{1, 2, 3, 4, 2, 6, 4, 8, 9}},
+		{CumMax, Rows, []float64{1, 2, 3, 4, 4, 6, -7, 8, 9}},
+		{CumMin, Cols, []float64{1, 2, 3, 1, -5, 3, -7, -5, 3}},
+		{CumMin, Rows, []float64{1, 1, 1, 4, -5, -5, -7, -7, -7}},
+	}
+
+	for _, tc := range tests {
+		res, err := tc.fn(tc.dim, mx)
+		assert.NoError(err)
+		assert.True(mat.Equal(mat.NewDense(3, 3, tc.want), res))
+
+		res, err = tc.fn(Dim(3), mx)
+		assert.Nil(res)
+		assert.True(errors.Is(err, ErrInvalidDim))
+
+		res, err = tc.fn(tc.dim, nil)
+		assert.Nil(res)
+		assert.True(errors.Is(err, ErrNilMatrix))
+	}
+
+	// the original matrix must not be modified
+	assert.True(mat.Equal(mat.NewDense(3, 3, []float64{1, 2, 3, 4, -5, 6, -7, 8, 9}), mx))
+
+	// integral image is a cumulative sum along both dimensions
+	cs, _ := CumSum(Cols, mx)
+	ii, err := CumSum(Rows, cs)
+	assert.NoError(err)
+	assert.Equal(mat.Sum(mx), ii.At(2, 2))
+
+	// NaNs are propagated
+	res, err := CumMax(Cols, mat.NewDense(3, 1, []float64{1, math.NaN(), 2}))
+	assert.NoError(err)
+	assert.Equal(1.0, res.At(0, 0))
+	assert.True(math.IsNaN(res.At(2, 0)))
+}
+
+func TestDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1, 2, 4, 7,
+		0, 3, 9, 27,
+		5, 5, 5, 5,
+	}
+	mx := mat.NewDense(3, 4, data)
+
+	d, err := Diff(Rows, 1, mx)
+	assert.NoError(err)
+	assert.True(mat.Equal(mat.NewDense(3, 3, []float64{
+		1, 2, 3,
+		3, 6, 18,
+		0, 0, 0,
+	}), d))
+
+	d, err = Diff(Rows, 2, mx)
+	assert.NoError(err)
+	assert.True(mat.Equal(mat.NewDense(3, 2, []float64{
+		1, 1,
+		3, 12,
+		0, 0,
+	}), d))
+
+	d, err = Diff(Cols, 1, mx)
+	assert.NoError(err)
+	assert.True(mat.Equal(mat.NewDense(2, 4, []float64{
+		-1, 1, 5, 20,
+		5, 2, -4, -22,
+	}), d))
+
+	d, err = Diff(Cols, 0, mx)
+	assert.NoError(err)
+	assert.True(mat.Equal(mx, d))
+
+	for _, n := range []int{-1, 3} {
+		d, err = Diff(Cols, n, mx)
+		assert.Nil(d)
+		assert.Error(err)
+	}
+
+	d, err = Diff(Dim(5), 1, mx)
+	assert.Nil(d)
+	assert.True(errors.Is(err, ErrInvalidDim))
+}
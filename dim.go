@@ -0,0 +1,51 @@
+package matrix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dim is matrix dimension.
+// The zero value of Dim is not a valid dimension so an unset Dim is
+// reported as an error instead of silently selecting either dimension.
+type Dim int
+
+const (
+	// Rows is matrix rows dimension
+	Rows Dim = iota + 1
+	// Cols is matrix columns dimension
+	Cols
+)
+
+// String implements fmt.Stringer interface
+func (d Dim) String() string {
+	switch d {
+	case Rows:
+		return "rows"
+	case Cols:
+		return "cols"
+	default:
+		return fmt.Sprintf("Dim(%d)", int(d))
+	}
+}
+
+// ParseDim parses dimension from string s. s is case insensitive and
+// must be either "rows" or "cols". It returns error if s is not a valid dimension.
+func ParseDim(s string) (Dim, error) {
+	switch {
+	case strings.EqualFold(s, "rows"):
+		return Rows, nil
+	case strings.EqualFold(s, "cols"):
+		return Cols, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDim, s)
+	}
+}
+
+// validate returns error if d is not a valid dimension
+func (d Dim) validate() error {
+	if d != Rows && d != Cols {
+		return fmt.Errorf("%w: %v", ErrInvalidDim, d)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDim(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("rows", Rows.String())
+	assert.Equal("cols", Cols.String())
+	assert.Equal("Dim(5)", Dim(5).String())
+
+	// zero value is not a valid dimension
+	var dim Dim
+	assert.True(errors.Is(dim.validate(), ErrInvalidDim))
+	assert.NoError(Rows.validate())
+	assert.NoError(Cols.validate())
+
+	tests := []struct {
+		s   string
+		dim Dim
+	}{
+		{"rows", Rows},
+		{"ROWS", Rows},
+		{"cols", Cols},
+		{"Cols", Cols},
+	}
+
+	for _, tc := range tests {
+		dim, err := ParseDim(tc.s)
+		assert.NoError(err)
+		assert.Equal(tc.dim, dim)
+	}
+
+	for _, s := range []string{"", "row", "colss"} {
+		_, err := ParseDim(s)
+		assert.Error(err)
+	}
+}
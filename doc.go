@@ -1,4 +1,9 @@
 // Package matrix provides a list of useful functions when working with gonum matrices.
 //
 // Among many other functions it allows you calculate sums, mean and stddev values along chosen matrix dimensions.
+//
+// Functions which modify a matrix in place accept *mat.Dense rather than an interface.
+// Symmetric, triangular, band and transposed gonum matrices restrict which elements can be set,
+// so *mat.Dense, including views returned by its Slice method, is the only general matrix type
+// which can be modified in place.
 package matrix
@@ -0,0 +1,286 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Elementwise functions below apply a function to every element of a matrix.
+// The plain variants modify the matrix m passed in as a parameter and return it.
+// The Into variants store the result in dst which must either be empty, in which case
+// it is resized to the dimensions of m, or have the same dimensions as m. dst may be m
+// or share data with it, e.g. be transposed m, in which case the result is computed
+// in a temporary matrix and copied into dst.
+
+// Abs sets every element of matrix m to its absolute value.
+// It fails with error if nil or empty matrix is supplied.
+func Abs(m *mat.Dense) (*mat.Dense, error) {
+	return inPlace(m, math.Abs)
+}
+
+// AbsInto stores the absolute values of elements of matrix m in dst.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func AbsInto(dst *mat.Dense, m mat.Matrix) error {
+	return elementwise(dst, m, math.Abs)
+}
+
+// Sqrt sets every element of matrix m to its square root.
+// Negative elements are set to NaN.
+// It fails with error if nil or empty matrix is supplied.
+func Sqrt(m *mat.Dense) (*mat.Dense, error) {
+	return inPlace(m, math.Sqrt)
+}
+
+// SqrtInto stores the square roots of elements of matrix m in dst.
+// Negative elements yield NaN.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func SqrtInto(dst *mat.Dense, m mat.Matrix) error {
+	return elementwise(dst, m, math.Sqrt)
+}
+
+// Exp sets every element x of matrix m to e**x.
+// It fails with error if nil or empty matrix is supplied.
+func Exp(m *mat.Dense) (*mat.Dense, error) {
+	return inPlace(m, math.Exp)
+}
+
+// ExpInto stores e**x for every element x of matrix m in dst.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func ExpInto(dst *mat.Dense, m mat.Matrix) error {
+	return elementwise(dst, m, math.Exp)
+}
+
+// Log sets every element of matrix m to its natural logarithm.
+// Zero elements are set to -Inf and negative elements are set to NaN.
+// It fails with error if nil or empty matrix is supplied.
+func Log(m *mat.Dense) (*mat.Dense, error) {
+	return inPlace(m, math.Log)
+}
+
+// LogInto stores the natural logarithms of elements of matrix m in dst.
+// Zero elements yield -Inf and negative elements yield NaN.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func LogInto(dst *mat.Dense, m mat.Matrix) error {
+	return elementwise(dst, m, math.Log)
+}
+
+// Log1p sets every element x of matrix m to the natural logarithm of 1+x.
+// It is more accurate than Log when x is near zero.
+// It fails with error if nil or empty matrix is supplied.
+func Log1p(m *mat.Dense) (*mat.Dense, error) {
+	return inPlace(m, math.Log1p)
+}
+
+// Log1pInto stores the natural logarithm of 1+x for every element x of matrix m in dst.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func Log1pInto(dst *mat.Dense, m mat.Matrix) error {
+	return elementwise(dst, m, math.Log1p)
+}
+
+// Pow sets every element x of matrix m to x**p.
+// It fails with error if nil or empty matrix is supplied.
+func Pow(m *mat.Dense, p float64) (*mat.Dense, error) {
+	return inPlace(m, pow(p))
+}
+
+// PowInto stores x**p for every element x of matrix m in dst.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func PowInto(dst *mat.Dense, m mat.Matrix, p float64) error {
+	return elementwise(dst, m, pow(p))
+}
+
+// Clip limits every element of matrix m to interval [min, max].
+// NaN elements are left unchanged.
+// It fails with error if nil or empty matrix is supplied or if min is bigger than max.
+func Clip(m *mat.Dense, min, max float64) (*mat.Dense, error) {
+	if err := validClipArgs(min, max); err != nil {
+		return nil, err
+	}
+	return inPlace(m, clip(min, max))
+}
+
+// ClipInto stores the elements of matrix m limited to interval [min, max] in dst.
+// NaN elements are left unchanged.
+// It fails with error if either of the matrices is nil, m has zero size, dst dimensions
+// do not match or min is bigger than max.
+func ClipInto(dst *mat.Dense, m mat.Matrix, min, max float64) error {
+	if err := validClipArgs(min, max); err != nil {
+		return err
+	}
+	return elementwise(dst, m, clip(min, max))
+}
+
+// Sign sets every element of matrix m to -1, 0 or 1 according to its sign.
+// NaN elements are left unchanged.
+// It fails with error if nil or empty matrix is supplied.
+func Sign(m *mat.Dense) (*mat.Dense, error) {
+	return inPlace(m, sign)
+}
+
+// SignInto stores the signs of elements of matrix m in dst as -1, 0 or 1.
+// NaN elements are left unchanged.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func SignInto(dst *mat.Dense, m mat.Matrix) error {
+	return elementwise(dst, m, sign)
+}
+
+// Round rounds every element of matrix m to prec decimal places, rounding half away from zero.
+// Negative prec rounds to the left of the decimal point.
+// It fails with error if nil or empty matrix is supplied.
+func Round(m *mat.Dense, prec int) (*mat.Dense, error) {
+	return inPlace(m, round(prec))
+}
+
+// RoundInto stores the elements of matrix m rounded to prec decimal places in dst.
+// Negative prec rounds to the left of the decimal point.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func RoundInto(dst *mat.Dense, m mat.Matrix, prec int) error {
+	return elementwise(dst, m, round(prec))
+}
+
+// Sigmoid sets every element x of matrix m to the logistic function 1/(1+e**-x).
+// It fails with error if nil or empty matrix is supplied.
+func Sigmoid(m *mat.Dense) (*mat.Dense, error) {
+	return inPlace(m, sigmoid)
+}
+
+// SigmoidInto stores the logistic function 1/(1+e**-x) of every element x of matrix m in dst.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func SigmoidInto(dst *mat.Dense, m mat.Matrix) error {
+	return elementwise(dst, m, sigmoid)
+}
+
+// Tanh sets every element of matrix m to its hyperbolic tangent.
+// It fails with error if nil or empty matrix is supplied.
+func Tanh(m *mat.Dense) (*mat.Dense, error) {
+	return inPlace(m, math.Tanh)
+}
+
+// TanhInto stores the hyperbolic tangents of elements of matrix m in dst.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func TanhInto(dst *mat.Dense, m mat.Matrix) error {
+	return elementwise(dst, m, math.Tanh)
+}
+
+// ReLU sets every negative element of matrix m to zero.
+// It fails with error if nil or empty matrix is supplied.
+func ReLU(m *mat.Dense) (*mat.Dense, error) {
+	return inPlace(m, relu)
+}
+
+// ReLUInto stores the elements of matrix m with negative elements set to zero in dst.
+// It fails with error if either of the matrices is nil, m has zero size or dst dimensions do not match.
+func ReLUInto(dst *mat.Dense, m mat.Matrix) error {
+	return elementwise(dst, m, relu)
+}
+
+// validClipArgs returns error if min is bigger than max or either of them is NaN
+func validClipArgs(min, max float64) error {
+	if !(min <= max) {
+		return fmt.Errorf("invalid clip interval: [%f, %f]", min, max)
+	}
+	return nil
+}
+
+func pow(p float64) func(float64) float64 {
+	return func(x float64) float64 { return math.Pow(x, p) }
+}
+
+func clip(min, max float64) func(float64) float64 {
+	return func(x float64) float64 {
+		if x < min {
+			return min
+		}
+		if x > max {
+			return max
+		}
+		return x
+	}
+}
+
+func round(prec int) func(float64) float64 {
+	return func(x float64) float64 { return scalar.Round(x, prec) }
+}
+
+func sign(x float64) float64 {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	case x == 0:
+		return 0
+	}
+	return x
+}
+
+// sigmoid computes logistic function avoiding overflow of e**-x for large negative x
+func sigmoid(x float64) float64 {
+	if x >= 0 {
+		return 1 / (1 + math.Exp(-x))
+	}
+	e := math.Exp(x)
+	return e / (1 + e)
+}
+
+func relu(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return x
+}
+
+// inPlace applies fn to every element of m and returns m
+func inPlace(m *mat.Dense, fn func(float64) float64) (*mat.Dense, error) {
+	if err := elementwise(m, m, fn); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// elementwise applies fn to every element of m and stores the results in dst.
+// Rows of m which implements mat.RawMatrixer are read directly from its backing data.
+func elementwise(dst *mat.Dense, m mat.Matrix, fn func(float64) float64) error {
+	if dst == nil {
+		return fmt.Errorf("%w: %v", ErrNilMatrix, dst)
+	}
+	if err := validMatrix(m); err != nil {
+		return err
+	}
+	rows, cols := m.Dims()
+	if err := reuseAs(dst, rows, cols); err != nil {
+		return err
+	}
+
+	var row func(i int) []float64
+	if rm, ok := m.(mat.RawMatrixer); ok {
+		raw := rm.RawMatrix()
+		row = func(i int) []float64 { return raw.Data[i*raw.Stride : i*raw.Stride+cols] }
+	} else {
+		buf := make([]float64, cols)
+		row = func(i int) []float64 { return mat.Row(buf, i, m) }
+	}
+
+	// rows of m read after writing into dst must not see the written values
+	res := dst
+	if aliased(dst, m) {
+		res = mat.NewDense(rows, cols, nil)
+	}
+
+	for i := 0; i < rows; i++ {
+		x := row(i)
+		out := res.RawRowView(i)
+		for j := range out {
+			out[j] = fn(x[j])
+		}
+	}
+
+	if res != dst {
+		dst.Copy(res)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestElementwise(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		-2.0, -0.5, 0.0,
+		0.25, 1.0, 4.0,
+	}
+
+	tests := []struct {
+		fn   func(*mat.Dense) (*mat.Dense, error)
+		into func(*mat.Dense, mat.Matrix) error
+		exp  []float64
+	}{
+		{Abs, AbsInto, []float64{2.0, 0.5, 0.0, 0.25, 1.0, 4.0}},
+		{Sqrt, SqrtInto, []float64{math.NaN(), math.NaN(), 0.0, 0.5, 1.0, 2.0}},
+		{Exp, ExpInto, []float64{math.Exp(-2.0), math.Exp(-0.5), 1.0, math.Exp(0.25), math.E, math.Exp(4.0)}},
+		{Log, LogInto, []float64{math.NaN(), math.NaN(), math.Inf(-1), math.Log(0.25), 0.0, math.Log(4.0)}},
+		{Log1p, Log1pInto, []float64{math.NaN(), math.Log(0.5), 0.0, math.Log(1.25), math.Ln2, math.Log(5.0)}},
+		{Sign, SignInto, []float64{-1.0, -1.0, 0.0, 1.0, 1.0, 1.0}},
+		{Sigmoid, SigmoidInto, []float64{1 / (1 + math.Exp(2.0)), 1 / (1 + math.Exp(0.5)), 0.5, 1 / (1 + math.Exp(-0.25)), 1 / (1 + math.Exp(-1.0)), 1 / (1 + math.Exp(-4.0))}},
+		{Tanh, TanhInto, []float64{math.Tanh(-2.0), math.Tanh(-0.5), 0.0, math.Tanh(0.25), math.Tanh(1.0), math.Tanh(4.0)}},
+		{ReLU, ReLUInto, []float64{0.0, 0.0, 0.0, 0.25, 1.0, 4.0}},
+	}
+
+	for _, tc := range tests {
+		exp := mat.NewDense(2, 3, tc.exp)
+
+		m := mat.NewDense(2, 3, append([]float64(nil), data...))
+		res, err := tc.fn(m)
+		assert.NoError(err)
+		assert.True(res == m)
+		assert.True(equalApproxNaN(exp, m, 1e-12))
+
+		// raw matrix
+		dst := &mat.Dense{}
+		src := mat.NewDense(2, 3, append([]float64(nil), data...))
+		assert.NoError(tc.into(dst, src))
+		assert.True(equalApproxNaN(exp, dst, 1e-12))
+		assert.Equal(data, src.RawMatrix().Data)
+
+		// generic matrix
+		dst = mat.NewDense(2, 3, nil)
+		assert.NoError(tc.into(dst, src.T().T()))
+		assert.True(equalApproxNaN(exp, dst, 1e-12))
+
+		res, err = tc.fn(nil)
+		assert.Nil(res)
+		assert.True(errors.Is(err, ErrNilMatrix))
+
+		res, err = tc.fn(&mat.Dense{})
+		assert.Nil(res)
+		assert.True(errors.Is(err, ErrZeroSize))
+
+		err = tc.into(mat.NewDense(3, 2, nil), src)
+		assert.Equal(&DimError{Expected: Shape{2, 3}, Actual: Shape{3, 2}}, err)
+	}
+}
+
+// equalApproxNaN returns true if a and b are approximately equal treating NaN elements as equal
+func equalApproxNaN(a, b mat.Matrix, tol float64) bool {
+	nan := func(_, _ int, v float64) float64 {
+		if math.IsNaN(v) {
+			return math.MaxFloat64
+		}
+		return v
+	}
+	x, y := &mat.Dense{}, &mat.Dense{}
+	x.Apply(nan, a)
+	y.Apply(nan, b)
+	return mat.EqualApprox(x, y, tol)
+}
+
+func TestElementwiseAliased(t *testing.T) {
+	assert := assert.New(t)
+
+	// transposed dst is read before it is overwritten
+	d := mat.NewDense(2, 2, []float64{1.0, -2.0, 3.0, -4.0})
+	assert.NoError(AbsInto(d, d.T()))
+	assert.Equal([]float64{1.0, 3.0, 2.0, 4.0}, d.RawMatrix().Data)
+
+	// overlapping views of the same matrix
+	f := mat.NewDense(3, 2, []float64{1.0, 4.0, 9.0, 16.0, 25.0, 36.0})
+	assert.NoError(SqrtInto(f.Slice(1, 3, 0, 2).(*mat.Dense), f.Slice(0, 2, 0, 2)))
+	assert.Equal([]float64{1.0, 4.0, 1.0, 2.0, 3.0, 4.0}, f.RawMatrix().Data)
+
+	// masks are computed by the same helper
+	nan := math.NaN()
+	g := mat.NewDense(2, 2, []float64{nan, 1.0, 2.0, 3.0})
+	m, err := IsNaN(g.T())
+	assert.NoError(err)
+	assert.Equal([]float64{1.0, 0.0, 0.0, 0.0}, m.RawMatrix().Data)
+}
+
+func TestElementwiseParams(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		-2.0, -0.55, 0.0,
+		0.25, 1.0, 4.0,
+	}
+
+	m := mat.NewDense(2, 3, append([]float64(nil), data...))
+	_, err := Pow(m, 2.0)
+	assert.NoError(err)
+	exp := mat.NewDense(2, 3, []float64{4.0, 0.3025, 0.0, 0.0625, 1.0, 16.0})
+	assert.True(mat.EqualApprox(exp, m, 1e-12))
+
+	dst := &mat.Dense{}
+	assert.NoError(PowInto(dst, mat.NewDense(2, 3, data), 0.5))
+	assert.Equal(2.0, dst.At(1, 2))
+
+	m = mat.NewDense(2, 3, append([]float64(nil), data...))
+	_, err = Clip(m, -1.0, 1.0)
+	assert.NoError(err)
+	exp = mat.NewDense(2, 3, []float64{-1.0, -0.55, 0.0, 0.25, 1.0, 1.0})
+	assert.True(mat.Equal(exp, m))
+
+	dst = &mat.Dense{}
+	assert.NoError(ClipInto(dst, mat.NewDense(2, 3, data), 0.0, math.Inf(1)))
+	exp = mat.NewDense(2, 3, []float64{0.0, 0.0, 0.0, 0.25, 1.0, 4.0})
+	assert.True(mat.Equal(exp, dst))
+
+	for _, lim := range [][2]float64{{1.0, -1.0}, {math.NaN(), 1.0}} {
+		res, err := Clip(m, lim[0], lim[1])
+		assert.Nil(res)
+		assert.Error(err)
+		assert.Error(ClipInto(dst, m, lim[0], lim[1]))
+	}
+
+	m = mat.NewDense(2, 3, append([]float64(nil), data...))
+	_, err = Round(m, 1)
+	assert.NoError(err)
+	exp = mat.NewDense(2, 3, []float64{-2.0, -0.6, 0.0, 0.3, 1.0, 4.0})
+	assert.True(mat.EqualApprox(exp, m, 1e-12))
+
+	dst = &mat.Dense{}
+	assert.NoError(RoundInto(dst, mat.NewDense(2, 3, data), 0))
+	exp = mat.NewDense(2, 3, []float64{-2.0, -1.0, 0.0, 0.0, 1.0, 4.0})
+	assert.True(mat.Equal(exp, dst))
+
+	// NaN values are propagated
+	m = mat.NewDense(1, 2, []float64{math.NaN(), -1.0})
+	_, err = Sign(m)
+	assert.NoError(err)
+	assert.True(math.IsNaN(m.At(0, 0)))
+
+	// sigmoid does not overflow
+	m = mat.NewDense(1, 2, []float64{-1000.0, 1000.0})
+	_, err = Sigmoid(m)
+	assert.NoError(err)
+	assert.Equal([]float64{0.0, 1.0}, m.RawRowView(0))
+}
@@ -0,0 +1,57 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNilMatrix is returned when nil matrix is supplied
+	ErrNilMatrix = errors.New("invalid matrix supplied")
+	// ErrZeroSize is returned when matrix has or would have zero size
+	ErrZeroSize = errors.New("zero size matrix")
+	// ErrDimMismatch is returned when matrix dimensions do not match
+	ErrDimMismatch = errors.New("dimension mismatch")
+	// ErrNotSymmetric is returned when matrix is not symmetric
+	ErrNotSymmetric = errors.New("matrix not symmetric")
+	// ErrNotSquare is returned when matrix is not square
+	ErrNotSquare = errors.New("matrix must be square")
+	// ErrInvalidDim is returned when invalid matrix dimension is supplied
+	ErrInvalidDim = errors.New("invalid dimension")
+	// ErrIndexOutOfRange is returned when row or column index is out of range
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrNotFitted is returned when unfitted scaler is used
+	ErrNotFitted = errors.New("scaler not fitted")
+)
+
+// Shape is matrix shape
+type Shape struct {
+	// Rows is number of matrix rows
+	Rows int
+	// Cols is number of matrix columns
+	Cols int
+}
+
+// String implements fmt.Stringer interface
+func (s Shape) String() string {
+	return fmt.Sprintf("%dx%d", s.Rows, s.Cols)
+}
+
+// DimError is returned when matrix dimensions do not match the expected dimensions.
+// DimError matches ErrDimMismatch when compared with errors.Is.
+type DimError struct {
+	// Expected is the expected matrix shape
+	Expected Shape
+	// Actual is the actual matrix shape
+	Actual Shape
+}
+
+// Error implements error interface
+func (e *DimError) Error() string {
+	return fmt.Sprintf("%v: expected %v, got %v", ErrDimMismatch, e.Expected, e.Actual)
+}
+
+// Is reports whether target is ErrDimMismatch
+func (e *DimError) Is(target error) bool {
+	return target == ErrDimMismatch
+}
@@ -0,0 +1,68 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestDimError(t *testing.T) {
+	assert := assert.New(t)
+
+	var err error = &DimError{Expected: Shape{2, 3}, Actual: Shape{3, 3}}
+	assert.EqualError(err, "dimension mismatch: expected 2x3, got 3x3")
+	assert.True(errors.Is(err, ErrDimMismatch))
+	assert.False(errors.Is(err, ErrNotSquare))
+
+	var dimErr *DimError
+	assert.True(errors.As(err, &dimErr))
+	assert.Equal(Shape{2, 3}, dimErr.Expected)
+	assert.Equal(Shape{3, 3}, dimErr.Actual)
+}
+
+func TestSentinelErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewDenseVal(0, 2, 1.0)
+	assert.True(errors.Is(err, ErrZeroSize))
+
+	_, err = AddVal(nil, 1.0)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	_, err = AddVal(&mat.Dense{}, 1.0)
+	assert.True(errors.Is(err, ErrZeroSize))
+
+	_, err = ColsSum(1, &mat.Dense{})
+	assert.True(errors.Is(err, ErrZeroSize))
+
+	m := mat.NewDense(2, 2, []float64{1, 2, 3, 4})
+	_, err = RowsMean(3, m)
+	assert.True(errors.Is(err, ErrDimMismatch))
+	var dimErr *DimError
+	assert.True(errors.As(err, &dimErr))
+	assert.Equal(Shape{3, 2}, dimErr.Expected)
+	assert.Equal(Shape{2, 2}, dimErr.Actual)
+
+	_, err = Sum(Dim(3), 1, m)
+	assert.True(errors.Is(err, ErrInvalidDim))
+
+	_, err = ColsSum(-1, m)
+	assert.True(errors.Is(err, ErrIndexOutOfRange))
+
+	_, err = Variance(Rows, -1, m, 0, nil)
+	assert.True(errors.Is(err, ErrIndexOutOfRange))
+
+	_, err = Cov(m, "foo")
+	assert.True(errors.Is(err, ErrInvalidDim))
+
+	_, err = CovDim(nil, Rows)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	_, err = Linspace(0, 1, 0)
+	assert.True(errors.Is(err, ErrZeroSize))
+
+	_, err = NewDenseTridiag([]float64{1}, []float64{1, 2, 3}, []float64{1, 2})
+	assert.True(errors.Is(err, ErrDimMismatch))
+}
@@ -0,0 +1,90 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Linspace returns a vector of n evenly spaced numbers over interval [start, stop].
+// If n is 1, the returned vector contains only start.
+// Linspace fails if n is not positive.
+func Linspace(start, stop float64, n int) (*mat.VecDense, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: invalid number of samples: %d", ErrZeroSize, n)
+	}
+	data := make([]float64, n)
+	if n == 1 {
+		data[0] = start
+		return mat.NewVecDense(n, data), nil
+	}
+	step := (stop - start) / float64(n-1)
+	for i := range data {
+		data[i] = start + float64(i)*step
+	}
+	// make sure the interval end is exact
+	data[n-1] = stop
+	return mat.NewVecDense(n, data), nil
+}
+
+// Logspace returns a vector of n numbers evenly spaced on a log scale i.e. the elements
+// of the vector are base raised to the powers evenly spaced over interval [start, stop].
+// Logspace fails if n is not positive.
+func Logspace(start, stop float64, n int, base float64) (*mat.VecDense, error) {
+	v, err := Linspace(start, stop, n)
+	if err != nil {
+		return nil, err
+	}
+	data := v.RawVector().Data
+	for i := range data {
+		data[i] = math.Pow(base, data[i])
+	}
+	return v, nil
+}
+
+// Arange returns a vector of numbers evenly spaced by step over half-open interval [start, stop).
+// Arange fails if step is zero, if the interval contains no numbers or if it contains
+// more than math.MaxInt32 numbers.
+func Arange(start, stop, step float64) (*mat.VecDense, error) {
+	if step == 0 || math.IsNaN(step) {
+		return nil, fmt.Errorf("invalid step: %f", step)
+	}
+	n := math.Ceil((stop - start) / step)
+	if !(n > 0) {
+		return nil, fmt.Errorf("%w: empty range: [%f, %f) step %f", ErrZeroSize, start, stop, step)
+	}
+	if n > math.MaxInt32 {
+		return nil, fmt.Errorf("range too large: [%f, %f) step %f", start, stop, step)
+	}
+	data := make([]float64, int(n))
+	for i := range data {
+		data[i] = start + float64(i)*step
+	}
+	return mat.NewVecDense(len(data), data), nil
+}
+
+// Meshgrid returns coordinate matrices from coordinate vectors x and y.
+// Both returned matrices have y.Len() rows and x.Len() columns: each row of
+// the first matrix is a copy of x and each column of the second matrix is a copy of y.
+// Meshgrid fails if either x or y is nil or empty.
+func Meshgrid(x, y mat.Vector) (*mat.Dense, *mat.Dense, error) {
+	if isNil(x) || isNil(y) {
+		return nil, nil, fmt.Errorf("%w: %v, %v", ErrNilMatrix, x, y)
+	}
+	cols, rows := x.Len(), y.Len()
+	if rows == 0 || cols == 0 {
+		return nil, nil, fmt.Errorf("%w: invalid vector length: %d, %d", ErrZeroSize, cols, rows)
+	}
+
+	xx := mat.NewDense(rows, cols, nil)
+	yy := mat.NewDense(rows, cols, nil)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			xx.Set(i, j, x.AtVec(j))
+			yy.Set(i, j, y.AtVec(i))
+		}
+	}
+
+	return xx, yy, nil
+}
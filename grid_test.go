@@ -0,0 +1,95 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestLinspace(t *testing.T) {
+	assert := assert.New(t)
+
+	v, err := Linspace(0.0, 1.0, 5)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{0.0, 0.25, 0.5, 0.75, 1.0}, v.RawVector().Data, 1e-12)
+
+	v, err = Linspace(2.0, 1.0, 1)
+	assert.NoError(err)
+	assert.Equal([]float64{2.0}, v.RawVector().Data)
+
+	v, err = Linspace(0.0, 1.0, 0)
+	assert.Nil(v)
+	assert.Error(err)
+}
+
+func TestLogspace(t *testing.T) {
+	assert := assert.New(t)
+
+	v, err := Logspace(0.0, 3.0, 4, 10.0)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{1.0, 10.0, 100.0, 1000.0}, v.RawVector().Data, 1e-9)
+
+	v, err = Logspace(0.0, 3.0, -1, 10.0)
+	assert.Nil(v)
+	assert.Error(err)
+}
+
+func TestArange(t *testing.T) {
+	assert := assert.New(t)
+
+	v, err := Arange(0.0, 1.0, 0.25)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{0.0, 0.25, 0.5, 0.75}, v.RawVector().Data, 1e-12)
+
+	v, err = Arange(3.0, 0.0, -1.0)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{3.0, 2.0, 1.0}, v.RawVector().Data, 1e-12)
+
+	v, err = Arange(0.0, 1.0, 0.0)
+	assert.Nil(v)
+	assert.Error(err)
+
+	v, err = Arange(1.0, 0.0, 1.0)
+	assert.Nil(v)
+	assert.Error(err)
+
+	for _, stop := range []float64{1e300, math.Inf(1)} {
+		v, err = Arange(0.0, stop, 1.0)
+		assert.Nil(v)
+		assert.Error(err)
+	}
+}
+
+func TestMeshgrid(t *testing.T) {
+	assert := assert.New(t)
+
+	x := mat.NewVecDense(3, []float64{1, 2, 3})
+	y := mat.NewVecDense(2, []float64{4, 5})
+
+	xx, yy, err := Meshgrid(x, y)
+	assert.NoError(err)
+	expX := mat.NewDense(2, 3, []float64{
+		1, 2, 3,
+		1, 2, 3,
+	})
+	expY := mat.NewDense(2, 3, []float64{
+		4, 4, 4,
+		5, 5, 5,
+	})
+	assert.True(mat.Equal(expX, xx))
+	assert.True(mat.Equal(expY, yy))
+
+	xx, yy, err = Meshgrid(nil, y)
+	assert.Nil(xx)
+	assert.Nil(yy)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	var nilVec *mat.VecDense
+	xx, yy, err = Meshgrid(x, nilVec)
+	assert.Nil(xx)
+	assert.Nil(yy)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
@@ -0,0 +1,126 @@
+package matrix
+
+import (
+	"math"
+	"math/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Weight initialisers below treat rows as the number of input units (fan-in)
+// and cols as the number of output units (fan-out) of a network layer,
+// i.e. they assume the weights are applied as x * W for a row vector x.
+
+// NewDenseGlorotUniform creates a new rows x cols weight matrix initialized to random numbers
+// uniformly distributed in interval [-limit, limit] where limit is sqrt(6/(rows+cols)).
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseGlorotUniform fails if non-positive matrix dimensions are requested.
+func NewDenseGlorotUniform(rows, cols int, src rand.Source) (*mat.Dense, error) {
+	limit := math.Sqrt(6.0 / float64(rows+cols))
+	return NewDenseRandSrc(rows, cols, -limit, limit, src)
+}
+
+// NewDenseGlorotNormal creates a new rows x cols weight matrix initialized to random numbers
+// drawn from Normal distribution with zero mean and standard deviation sqrt(2/(rows+cols)).
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseGlorotNormal fails if non-positive matrix dimensions are requested.
+func NewDenseGlorotNormal(rows, cols int, src rand.Source) (*mat.Dense, error) {
+	sigma := math.Sqrt(2.0 / float64(rows+cols))
+	return NewDenseNormal(rows, cols, 0.0, sigma, src)
+}
+
+// NewDenseHeUniform creates a new rows x cols weight matrix initialized to random numbers
+// uniformly distributed in interval [-limit, limit] where limit is sqrt(6/rows).
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseHeUniform fails if non-positive matrix dimensions are requested.
+func NewDenseHeUniform(rows, cols int, src rand.Source) (*mat.Dense, error) {
+	limit := math.Sqrt(6.0 / float64(rows))
+	return NewDenseRandSrc(rows, cols, -limit, limit, src)
+}
+
+// NewDenseHeNormal creates a new rows x cols weight matrix initialized to random numbers
+// drawn from Normal distribution with zero mean and standard deviation sqrt(2/rows).
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseHeNormal fails if non-positive matrix dimensions are requested.
+func NewDenseHeNormal(rows, cols int, src rand.Source) (*mat.Dense, error) {
+	sigma := math.Sqrt(2.0 / float64(rows))
+	return NewDenseNormal(rows, cols, 0.0, sigma, src)
+}
+
+// NewDenseLeCunUniform creates a new rows x cols weight matrix initialized to random numbers
+// uniformly distributed in interval [-limit, limit] where limit is sqrt(3/rows).
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseLeCunUniform fails if non-positive matrix dimensions are requested.
+func NewDenseLeCunUniform(rows, cols int, src rand.Source) (*mat.Dense, error) {
+	limit := math.Sqrt(3.0 / float64(rows))
+	return NewDenseRandSrc(rows, cols, -limit, limit, src)
+}
+
+// NewDenseLeCunNormal creates a new rows x cols weight matrix initialized to random numbers
+// drawn from Normal distribution with zero mean and standard deviation sqrt(1/rows).
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseLeCunNormal fails if non-positive matrix dimensions are requested.
+func NewDenseLeCunNormal(rows, cols int, src rand.Source) (*mat.Dense, error) {
+	sigma := math.Sqrt(1.0 / float64(rows))
+	return NewDenseNormal(rows, cols, 0.0, sigma, src)
+}
+
+// NewDenseOrthogonal creates a new rows x cols weight matrix with orthonormal columns
+// (if rows >= cols) or orthonormal rows (if rows < cols) scaled by gain.
+// The matrix is obtained from QR factorization of a matrix of standard Normal random numbers
+// drawn from src. If src is nil, a new source seeded with the current time is used.
+// NewDenseOrthogonal fails if non-positive matrix dimensions are requested.
+func NewDenseOrthogonal(rows, cols int, gain float64, src rand.Source) (*mat.Dense, error) {
+	return withValidDims(rows, cols, func() (*mat.Dense, error) {
+		rnd := newRand(src)
+
+		if rows < cols {
+			q, err := orthogonal(cols, rows, rnd)
+			if err != nil {
+				return nil, err
+			}
+			m := &mat.Dense{}
+			m.Scale(gain, q.T())
+			return m, nil
+		}
+
+		q, err := orthogonal(rows, cols, rnd)
+		if err != nil {
+			return nil, err
+		}
+		q.Scale(gain, q)
+		return q, nil
+	})
+}
+
+// orthogonal returns a rows x cols matrix with orthonormal columns.
+// It requires rows >= cols. The signs of the columns are corrected using
+// the diagonal of R factor so that the result is uniformly (Haar) distributed.
+func orthogonal(rows, cols int, rnd *rand.Rand) (*mat.Dense, error) {
+	a, err := NewDenseNormal(rows, cols, 0.0, 1.0, rnd)
+	if err != nil {
+		return nil, err
+	}
+
+	var qr mat.QR
+	qr.Factorize(a)
+
+	var q, r mat.Dense
+	qr.QTo(&q)
+	qr.RTo(&r)
+
+	m := mat.DenseCopyOf(q.Slice(0, rows, 0, cols))
+	for j := 0; j < cols; j++ {
+		if r.At(j, j) < 0 {
+			col := m.ColView(j).(*mat.VecDense)
+			col.ScaleVec(-1, col)
+		}
+	}
+	return m, nil
+}
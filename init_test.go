@@ -0,0 +1,93 @@
+package matrix
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func TestNewDenseWeightInit(t *testing.T) {
+	assert := assert.New(t)
+
+	rows, cols := 200, 100
+
+	uniform := []struct {
+		fn    func(int, int, rand.Source) (*mat.Dense, error)
+		limit float64
+	}{
+		{NewDenseGlorotUniform, math.Sqrt(6.0 / float64(rows+cols))},
+		{NewDenseHeUniform, math.Sqrt(6.0 / float64(rows))},
+		{NewDenseLeCunUniform, math.Sqrt(3.0 / float64(rows))},
+	}
+
+	for _, tc := range uniform {
+		m, err := tc.fn(rows, cols, rand.NewSource(1))
+		assert.NoError(err)
+		r, c := m.Dims()
+		assert.Equal(rows, r)
+		assert.Equal(cols, c)
+		assert.True(mat.Max(m) <= tc.limit)
+		assert.True(mat.Min(m) >= -tc.limit)
+		assert.InDelta(tc.limit, mat.Max(m), 0.01*tc.limit)
+
+		m, err = tc.fn(0, cols, nil)
+		assert.Nil(m)
+		assert.Error(err)
+	}
+
+	normal := []struct {
+		fn    func(int, int, rand.Source) (*mat.Dense, error)
+		sigma float64
+	}{
+		{NewDenseGlorotNormal, math.Sqrt(2.0 / float64(rows+cols))},
+		{NewDenseHeNormal, math.Sqrt(2.0 / float64(rows))},
+		{NewDenseLeCunNormal, math.Sqrt(1.0 / float64(rows))},
+	}
+
+	for _, tc := range normal {
+		m, err := tc.fn(rows, cols, rand.NewSource(1))
+		assert.NoError(err)
+		vals := Unroll(m, true).RawVector().Data
+		mean, std := stat.MeanStdDev(vals, nil)
+		assert.InDelta(0.0, mean, 0.05*tc.sigma)
+		assert.InDelta(tc.sigma, std, 0.05*tc.sigma)
+
+		m, err = tc.fn(rows, -1, nil)
+		assert.Nil(m)
+		assert.Error(err)
+	}
+}
+
+func TestNewDenseOrthogonal(t *testing.T) {
+	assert := assert.New(t)
+
+	gain := 2.0
+	dims := [][2]int{{5, 5}, {6, 3}, {3, 6}}
+
+	for _, d := range dims {
+		m, err := NewDenseOrthogonal(d[0], d[1], gain, rand.NewSource(1))
+		assert.NoError(err)
+		r, c := m.Dims()
+		assert.Equal(d[0], r)
+		assert.Equal(d[1], c)
+
+		// (gain^2)I == W^T*W or W*W^T depending on the shape
+		p := &mat.Dense{}
+		if r >= c {
+			p.Mul(m.T(), m)
+		} else {
+			p.Mul(m, m.T())
+		}
+		n, _ := p.Dims()
+		exp, _ := NewDenseValIdentity(n, gain*gain)
+		assert.True(mat.EqualApprox(exp, p, 1e-10))
+	}
+
+	m, err := NewDenseOrthogonal(0, 3, gain, nil)
+	assert.Nil(m)
+	assert.Error(err)
+}
@@ -0,0 +1,196 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Masks are matrices whose elements are set to 1 where a condition holds and to 0 elsewhere.
+// Functions which accept a mask treat any non-zero element as true, so masks can be combined
+// using elementwise arithmetic e.g. Mul gives logical and of two masks.
+
+// Greater returns a mask which is 1 where elements of matrix a are greater than elements
+// of matrix b broadcast across it and 0 elsewhere. Comparisons with NaN are false.
+// b is broadcast across a in the same way as in Add.
+// It returns error if either of the matrices is nil, has zero size or b can not be broadcast across a.
+func Greater(a, b mat.Matrix) (*mat.Dense, error) {
+	return binary(a, b, func(x, y float64) float64 {
+		return boolVal(x > y)
+	})
+}
+
+// Less returns a mask which is 1 where elements of matrix a are less than elements
+// of matrix b broadcast across it and 0 elsewhere. Comparisons with NaN are false.
+// b is broadcast across a in the same way as in Add.
+// It returns error if either of the matrices is nil, has zero size or b can not be broadcast across a.
+func Less(a, b mat.Matrix) (*mat.Dense, error) {
+	return binary(a, b, func(x, y float64) float64 {
+		return boolVal(x < y)
+	})
+}
+
+// Equal returns a mask which is 1 where elements of matrix a differ from elements of matrix b
+// broadcast across it by at most tol and 0 elsewhere. Comparisons with NaN are false.
+// b is broadcast across a in the same way as in Add.
+// It returns error if either of the matrices is nil, has zero size, b can not be broadcast across a
+// or tol is negative.
+func Equal(a, b mat.Matrix, tol float64) (*mat.Dense, error) {
+	if !(tol >= 0) {
+		return nil, fmt.Errorf("invalid tolerance: %f", tol)
+	}
+	return binary(a, b, func(x, y float64) float64 {
+		return boolVal(x == y || math.Abs(x-y) <= tol)
+	})
+}
+
+// IsNaN returns a mask which is 1 where elements of matrix m are NaN and 0 elsewhere.
+// It returns error if passed in matrix is nil or has zero size.
+func IsNaN(m mat.Matrix) (*mat.Dense, error) {
+	dst := &mat.Dense{}
+	if err := elementwise(dst, m, func(x float64) float64 {
+		return boolVal(math.IsNaN(x))
+	}); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// IsInf returns a mask which is 1 where elements of matrix m are positive or negative infinity
+// and 0 elsewhere.
+// It returns error if passed in matrix is nil or has zero size.
+func IsInf(m mat.Matrix) (*mat.Dense, error) {
+	dst := &mat.Dense{}
+	if err := elementwise(dst, m, func(x float64) float64 {
+		return boolVal(math.IsInf(x, 0))
+	}); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// Where returns a new matrix whose elements are taken from matrix a where mask is non-zero
+// and from matrix b elsewhere.
+// It returns error if any of the matrices is nil, has zero size or the dimensions
+// of a or b do not match the dimensions of mask.
+func Where(mask, a, b mat.Matrix) (*mat.Dense, error) {
+	for _, m := range []mat.Matrix{mask, a, b} {
+		if err := validMatrix(m); err != nil {
+			return nil, err
+		}
+	}
+	rows, cols := mask.Dims()
+	for _, m := range []mat.Matrix{a, b} {
+		if r, c := m.Dims(); r != rows || c != cols {
+			return nil, &DimError{Expected: Shape{rows, cols}, Actual: Shape{r, c}}
+		}
+	}
+
+	res := mat.NewDense(rows, cols, nil)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if mask.At(i, j) != 0 {
+				res.Set(i, j, a.At(i, j))
+			} else {
+				res.Set(i, j, b.At(i, j))
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// MaskedFill sets elements of matrix m to val where mask is non-zero.
+// It modifies the matrix m passed in as a parameter and returns it.
+// It returns error if either of the matrices is nil, has zero size or their dimensions do not match.
+func MaskedFill(m *mat.Dense, mask mat.Matrix, val float64) (*mat.Dense, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%w: %v", ErrNilMatrix, m)
+	}
+	if err := validMatrix(mask); err != nil {
+		return nil, err
+	}
+	rows, cols := mask.Dims()
+	if r, c := m.Dims(); r != rows || c != cols {
+		return nil, &DimError{Expected: Shape{rows, cols}, Actual: Shape{r, c}}
+	}
+
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if mask.At(i, j) != 0 {
+				m.Set(i, j, val)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Count returns a slice of numbers of non-zero elements in mask rows or columns as selected by dim.
+// It returns error if passed in mask is nil, has zero size or dim is invalid.
+func Count(dim Dim, mask mat.Matrix) ([]int, error) {
+	res, err := Reduce(dim, mask, countNonZero, nil)
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]int, len(res))
+	for i := range res {
+		counts[i] = int(res[i])
+	}
+	return counts, nil
+}
+
+// Any returns a slice of booleans which are true if mask rows or columns as selected by dim
+// contain at least one non-zero element.
+// It returns error if passed in mask is nil, has zero size or dim is invalid.
+func Any(dim Dim, mask mat.Matrix) ([]bool, error) {
+	counts, err := Count(dim, mask)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]bool, len(counts))
+	for i, c := range counts {
+		res[i] = c > 0
+	}
+	return res, nil
+}
+
+// All returns a slice of booleans which are true if all elements of mask rows or columns
+// as selected by dim are non-zero.
+// It returns error if passed in mask is nil, has zero size or dim is invalid.
+func All(dim Dim, mask mat.Matrix) ([]bool, error) {
+	counts, err := Count(dim, mask)
+	if err != nil {
+		return nil, err
+	}
+	// number of elements in each row or column
+	rows, n := mask.Dims()
+	if dim == Cols {
+		n = rows
+	}
+	res := make([]bool, len(counts))
+	for i, c := range counts {
+		res[i] = c == n
+	}
+	return res, nil
+}
+
+// countNonZero returns the number of non-zero elements of vector v
+func countNonZero(v mat.Matrix) float64 {
+	var n float64
+	for _, x := range values(v) {
+		if x != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// boolVal returns 1 if b is true and 0 otherwise
+func boolVal(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,151 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCompare(t *testing.T) {
+	assert := assert.New(t)
+
+	nan := math.NaN()
+	a := mat.NewDense(2, 3, []float64{
+		1.0, 2.0, nan,
+		4.0, 5.0, 6.0,
+	})
+	threshold := mat.NewDense(1, 1, []float64{2.0})
+	row := mat.NewDense(1, 3, []float64{1.0, 3.0, 5.0})
+
+	m, err := Greater(a, threshold)
+	assert.NoError(err)
+	assert.Equal([]float64{0.0, 0.0, 0.0, 1.0, 1.0, 1.0}, m.RawMatrix().Data)
+
+	m, err = Less(a, row)
+	assert.NoError(err)
+	assert.Equal([]float64{0.0, 1.0, 0.0, 0.0, 0.0, 0.0}, m.RawMatrix().Data)
+
+	m, err = Equal(a, mat.NewDense(2, 3, []float64{1.0, 2.1, nan, 4.0, 4.95, 7.0}), 0.05)
+	assert.NoError(err)
+	assert.Equal([]float64{1.0, 0.0, 0.0, 1.0, 1.0, 0.0}, m.RawMatrix().Data)
+
+	inf := mat.NewDense(1, 2, []float64{math.Inf(1), math.Inf(-1)})
+	m, err = Equal(inf, inf, 0.0)
+	assert.NoError(err)
+	assert.Equal([]float64{1.0, 1.0}, m.RawMatrix().Data)
+
+	m, err = Equal(a, a, -1.0)
+	assert.Nil(m)
+	assert.Error(err)
+
+	m, err = Greater(a, mat.NewDense(1, 2, nil))
+	assert.Nil(m)
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	m, err = IsNaN(a)
+	assert.NoError(err)
+	assert.Equal([]float64{0.0, 0.0, 1.0, 0.0, 0.0, 0.0}, m.RawMatrix().Data)
+
+	m, err = IsInf(mat.NewDense(1, 3, []float64{math.Inf(-1), nan, math.Inf(1)}))
+	assert.NoError(err)
+	assert.Equal([]float64{1.0, 0.0, 1.0}, m.RawMatrix().Data)
+
+	m, err = IsNaN(nil)
+	assert.Nil(m)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
+
+func TestWhere(t *testing.T) {
+	assert := assert.New(t)
+
+	mask := mat.NewDense(2, 2, []float64{1.0, 0.0, 0.0, -1.0})
+	a := mat.NewDense(2, 2, []float64{1.0, 2.0, 3.0, 4.0})
+	b := mat.NewDense(2, 2, []float64{-1.0, -2.0, -3.0, -4.0})
+
+	m, err := Where(mask, a, b)
+	assert.NoError(err)
+	assert.Equal([]float64{1.0, -2.0, -3.0, 4.0}, m.RawMatrix().Data)
+
+	m, err = Where(mask, a, mat.NewDense(1, 2, nil))
+	assert.Nil(m)
+	assert.Equal(&DimError{Expected: Shape{2, 2}, Actual: Shape{1, 2}}, err)
+
+	m, err = Where(nil, a, b)
+	assert.Nil(m)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	// replace missing values with column means
+	nan := math.NaN()
+	mx := mat.NewDense(3, 2, []float64{1.0, nan, nan, 4.0, 3.0, 6.0})
+	nans, _ := IsNaN(mx)
+	means, _ := NanMean(Cols, 2, mx)
+	fill, _ := Add(mat.NewDense(3, 2, nil), mat.NewDense(1, 2, means))
+	m, err = Where(nans, fill, mx)
+	assert.NoError(err)
+	assert.Equal([]float64{1.0, 5.0, 2.0, 4.0, 3.0, 6.0}, m.RawMatrix().Data)
+}
+
+func TestMaskedFill(t *testing.T) {
+	assert := assert.New(t)
+
+	mx := mat.NewDense(2, 2, []float64{1.0, -2.0, -3.0, 4.0})
+	mask, _ := Less(mx, mat.NewDense(1, 1, nil))
+
+	m, err := MaskedFill(mx, mask, 0.0)
+	assert.NoError(err)
+	assert.True(m == mx)
+	assert.Equal([]float64{1.0, 0.0, 0.0, 4.0}, mx.RawMatrix().Data)
+
+	m, err = MaskedFill(mx, mat.NewDense(2, 3, nil), 0.0)
+	assert.Nil(m)
+	assert.Equal(&DimError{Expected: Shape{2, 3}, Actual: Shape{2, 2}}, err)
+
+	m, err = MaskedFill(nil, mask, 0.0)
+	assert.Nil(m)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
+
+func TestMaskReductions(t *testing.T) {
+	assert := assert.New(t)
+
+	mask := mat.NewDense(3, 3, []float64{
+		1.0, 0.0, 1.0,
+		1.0, 0.0, 1.0,
+		1.0, 0.0, 0.0,
+	})
+
+	count, err := Count(Cols, mask)
+	assert.NoError(err)
+	assert.Equal([]int{3, 0, 2}, count)
+
+	count, err = Count(Rows, mask)
+	assert.NoError(err)
+	assert.Equal([]int{2, 2, 1}, count)
+
+	flags, err := Any(Cols, mask)
+	assert.NoError(err)
+	assert.Equal([]bool{true, false, true}, flags)
+
+	flags, err = All(Cols, mask)
+	assert.NoError(err)
+	assert.Equal([]bool{true, false, false}, flags)
+
+	flags, err = All(Rows, mask.T())
+	assert.NoError(err)
+	assert.Equal([]bool{true, false, false}, flags)
+
+	flags, err = All(Rows, mat.NewDense(1, 2, []float64{1.0, 1.0}))
+	assert.NoError(err)
+	assert.Equal([]bool{true}, flags)
+
+	flags, err = Any(Dim(5), mask)
+	assert.Nil(flags)
+	assert.True(errors.Is(err, ErrInvalidDim))
+
+	count, err = Count(Rows, &mat.Dense{})
+	assert.Nil(count)
+	assert.True(errors.Is(err, ErrZeroSize))
+}
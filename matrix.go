@@ -1,14 +1,15 @@
 package matrix
 
 import (
-	"errors"
 	"fmt"
+	"math"
 	"math/rand"
-	"strings"
+	"reflect"
+	"time"
 
+	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/floats/scalar"
 	"gonum.org/v1/gonum/mat"
-	"gonum.org/v1/gonum/stat"
 )
 
 // Format returns matrix formatter for printing matrices
@@ -18,16 +19,26 @@ func Format(m mat.Matrix) fmt.Formatter {
 
 // NewDenseRand creates a new matrix with provided number of rows and columns
 // which is initialized to random numbers uniformly distributed in interval [min, max].
+// NewDenseRand uses its own fixed-seed random source, so it always returns the same
+// matrix for the same arguments and leaves the global math/rand source intact.
 // NewDenseRand fails if non-positive matrix dimensions are requested.
 func NewDenseRand(rows, cols int, min, max float64) (*mat.Dense, error) {
+	return NewDenseRandSrc(rows, cols, min, max, rand.NewSource(55))
+}
+
+// NewDenseRandSrc creates a new matrix with provided number of rows and columns
+// which is initialized to random numbers uniformly distributed in interval [min, max].
+// The random numbers are drawn from src; src can also be a *rand.Rand.
+// If src is nil, a new source seeded with the current time is used.
+// NewDenseRandSrc fails if non-positive matrix dimensions are requested.
+func NewDenseRandSrc(rows, cols int, min, max float64, src rand.Source) (*mat.Dense, error) {
 	return withValidDims(rows, cols, func() (*mat.Dense, error) {
-		// set random seed
-		rand.Seed(55)
+		rnd := newRand(src)
 		// allocate data slice
 		randVals := make([]float64, rows*cols)
 		for i := range randVals {
 			// we need value between 0 and 1.0
-			randVals[i] = rand.Float64()*(max-min) + min
+			randVals[i] = rnd.Float64()*(max-min) + min
 		}
 		return mat.NewDense(rows, cols, randVals), nil
 	})
@@ -65,103 +76,166 @@ func NewDenseValIdentity(n int, val float64) (*mat.Dense, error) {
 	})
 }
 
-// AddVal adds a constant value to every element of matrix
-// It modifies the matrix m passed in as a paramter.
-// AddConstant fails with error if empty matrix is supplied
+// AddVal adds a constant value to every element of matrix m.
+// It modifies the matrix m passed in as a parameter and returns it.
+// AddVal fails with error if nil or empty matrix is supplied.
 func AddVal(m *mat.Dense, val float64) (*mat.Dense, error) {
-	if m == nil {
-		return nil, fmt.Errorf("invalid matrix supplied: %v", m)
-	}
-	rows, cols := m.Dims()
-	return withValidDims(rows, cols, func() (*mat.Dense, error) {
-		// allocate zero matrix and set every element to val
-		for i := 0; i < rows; i++ {
-			for j := 0; j < cols; j++ {
-				m.Set(i, j, m.At(i, j)+val)
-			}
-		}
-		return m, nil
-	})
+	return inPlace(m, func(x float64) float64 { return x + val })
 }
 
 // ColsSum returns a slice of sum values of first cols number of matrix columns
 // It returns error if passed in matrix is nil, has zero size or requested number
 // of columns exceeds the number of columns in the matrix passed in as parameter.
-func ColsSum(cols int, m *mat.Dense) ([]float64, error) {
-	return withValidDim("cols", cols, m, mat.Sum)
+func ColsSum(cols int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Cols, cols, m, mat.Sum)
 }
 
 // ColsMax returns a slice of max values of first cols number of matrix columns
 // It returns error if passed in matrix is nil, has zero size or requested number
 // of columns exceeds the number of columns in the matrix passed in as parameter.
-func ColsMax(cols int, m *mat.Dense) ([]float64, error) {
-	return withValidDim("cols", cols, m, mat.Max)
+func ColsMax(cols int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Cols, cols, m, mat.Max)
 }
 
 // ColsMin returns a slice of min values of first cols number of matrix columns
 // It returns error if passed in matrix is nil, has zero size or requested number
 // of columns exceeds the number of columns in the matrix passed in as parameter.
-func ColsMin(cols int, m *mat.Dense) ([]float64, error) {
-	return withValidDim("cols", cols, m, mat.Min)
+func ColsMin(cols int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Cols, cols, m, mat.Min)
 }
 
 // ColsMean returns a slice of mean values of first cols matrix columns
 // It returns error if passed in matrix is nil or has zero size or requested number
 // of columns exceeds the number of columns in matrix m.
-func ColsMean(cols int, m *mat.Dense) ([]float64, error) {
-	return withValidDim("cols", cols, m, mean)
+func ColsMean(cols int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Cols, cols, m, mean)
 }
 
 // ColsStdev returns a slice of standard deviations of first cols matrix columns
 // It returns error if passed in matrix is nil or has zero size or requested number
 // of columns exceeds the number of columns in matrix m.
-func ColsStdev(cols int, m *mat.Dense) ([]float64, error) {
-	return withValidDim("cols", cols, m, stdev)
+func ColsStdev(cols int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Cols, cols, m, stdev)
+}
+
+// RowsStdev returns a slice of standard deviations of first rows matrix rows
+// It returns error if passed in matrix is nil or has zero size or requested number
+// of rows exceeds the number of rows in matrix m.
+func RowsStdev(rows int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Rows, rows, m, stdev)
 }
 
 // RowsMax returns a slice of max values of first rows matrix rows.
 // It returns error if passed in matrix is nil or has zero size or requested number
 // of rows exceeds the number of rows in matrix m.
-func RowsMax(rows int, m *mat.Dense) ([]float64, error) {
-	return withValidDim("rows", rows, m, mat.Max)
+func RowsMax(rows int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Rows, rows, m, mat.Max)
 }
 
 // RowsSum returns a slice of sum values of first rows number of matrix columns
 // It returns error if passed in matrix is nil, has zero size or requested number
 // of columns exceeds the number of columns in the matrix passed in as parameter.
-func RowsSum(rows int, m *mat.Dense) ([]float64, error) {
-	return withValidDim("rows", rows, m, mat.Sum)
+func RowsSum(rows int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Rows, rows, m, mat.Sum)
 }
 
 // RowsMin returns a slice of min values of first rows matrix rows.
 // It returns error if passed in matrix is nil or has zero size or requested number
 // of rows exceeds the number of rows in matrix m.
-func RowsMin(rows int, m *mat.Dense) ([]float64, error) {
-	return withValidDim("rows", rows, m, mat.Min)
+func RowsMin(rows int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Rows, rows, m, mat.Min)
 }
 
 // RowsMean returns a slice of mean values of first rows matrix rows
 // It returns error if passed in matrix is nil or has zero size or requested number
 // of columns exceeds the number of columns in matrix m.
-func RowsMean(rows int, m *mat.Dense) ([]float64, error) {
-	return withValidDim("rows", rows, m, mean)
+func RowsMean(rows int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(Rows, rows, m, mean)
+}
+
+// Sum returns a slice of sum values of first count matrix rows or columns
+// as selected by dim. It returns error if passed in matrix is nil, has zero size,
+// dim is invalid or requested count exceeds the size of the matrix dimension.
+func Sum(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, mat.Sum)
+}
+
+// Max returns a slice of max values of first count matrix rows or columns
+// as selected by dim. It returns error if passed in matrix is nil, has zero size,
+// dim is invalid or requested count exceeds the size of the matrix dimension.
+func Max(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, mat.Max)
+}
+
+// Min returns a slice of min values of first count matrix rows or columns
+// as selected by dim. It returns error if passed in matrix is nil, has zero size,
+// dim is invalid or requested count exceeds the size of the matrix dimension.
+func Min(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, mat.Min)
+}
+
+// Mean returns a slice of mean values of first count matrix rows or columns
+// as selected by dim. It returns error if passed in matrix is nil, has zero size,
+// dim is invalid or requested count exceeds the size of the matrix dimension.
+func Mean(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, mean)
 }
 
 // viewFunc defines matrix dimension view function
 type viewFunc func(int) mat.Vector
 
+// dimView returns a function which returns i-th row or column of matrix m as a vector.
+// The rows and columns of matrices which provide access to their raw data are returned
+// without copying, all the other matrices have their rows or columns copied into a buffer.
+// The returned vector is reused by subsequent calls so it must not be retained.
+func dimView(dim Dim, m mat.Matrix) viewFunc {
+	// rows of a transposed matrix are the columns of the original matrix
+	if t, ok := m.(mat.Untransposer); ok {
+		if _, ok := t.Untranspose().(mat.RawMatrixer); ok {
+			if dim == Rows {
+				return dimView(Cols, t.Untranspose())
+			}
+			return dimView(Rows, t.Untranspose())
+		}
+	}
+
+	rows, cols := m.Dims()
+	v := &mat.VecDense{}
+
+	if rm, ok := m.(mat.RawMatrixer); ok {
+		raw := rm.RawMatrix()
+		if dim == Rows {
+			return func(i int) mat.Vector {
+				v.SetRawVector(blas64.Vector{N: cols, Inc: 1, Data: raw.Data[i*raw.Stride : i*raw.Stride+cols]})
+				return v
+			}
+		}
+		return func(j int) mat.Vector {
+			v.SetRawVector(blas64.Vector{N: rows, Inc: raw.Stride, Data: raw.Data[j : (rows-1)*raw.Stride+j+1]})
+			return v
+		}
+	}
+
+	if dim == Rows {
+		buf := make([]float64, cols)
+		return func(i int) mat.Vector {
+			v.SetRawVector(blas64.Vector{N: cols, Inc: 1, Data: mat.Row(buf, i, m)})
+			return v
+		}
+	}
+	buf := make([]float64, rows)
+	return func(j int) mat.Vector {
+		v.SetRawVector(blas64.Vector{N: rows, Inc: 1, Data: mat.Col(buf, j, m)})
+		return v
+	}
+}
+
 // dimFn applies function fn to first count matrix rows or columns.
-// dim can be either set to rows or cols.
+// dim can be either set to Rows or Cols.
 // dimFn collects the results into a slice and returns it
-func dimFn(dim string, count int, m *mat.Dense, fn func(mat.Matrix) float64) []float64 {
+func dimFn(dim Dim, count int, m mat.Matrix, fn func(mat.Matrix) float64) []float64 {
 	res := make([]float64, count)
-	var viewFn viewFunc
-	switch dim {
-	case "rows":
-		viewFn = m.RowView
-	case "cols":
-		viewFn = m.ColView
-	}
+	viewFn := dimView(dim, m)
 	for i := 0; i < count; i++ {
 		res[i] = fn(viewFn(i))
 	}
@@ -170,37 +244,76 @@ func dimFn(dim string, count int, m *mat.Dense, fn func(mat.Matrix) float64) []f
 
 // withValidDim executes function fn on first count of matrix columns or rows.
 // It collects the results of each calculation and returns it in a slice.
-// It returns error if either matrix m is nil, has zero size or requested number of
-// particular dimension is larger than the matrix m dimensions.
-func withValidDim(dim string, count int, m *mat.Dense,
+// It returns error if either matrix m is nil, has zero size, dim is invalid, count is negative
+// or requested number of particular dimension is larger than the matrix m dimensions.
+func withValidDim(dim Dim, count int, m mat.Matrix,
 	fn func(mat.Matrix) float64) ([]float64, error) {
-	// matrix can't be nil
-	if m == nil {
-		return nil, fmt.Errorf("invalid matrix supplied: %v", m)
+	if err := validMatrix(m); err != nil {
+		return nil, err
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("%w: invalid count: %d", ErrIndexOutOfRange, count)
 	}
 	rows, cols := m.Dims()
 	switch dim {
-	case "rows":
+	case Rows:
 		if count > rows {
-			return nil, fmt.Errorf("row count exceeds matrix rows: %d", count)
+			return nil, &DimError{Expected: Shape{count, cols}, Actual: Shape{rows, cols}}
 		}
-	case "cols":
+	case Cols:
 		if count > cols {
-			return nil, fmt.Errorf("column count exceeds matrix columns: %d", count)
+			return nil, &DimError{Expected: Shape{rows, count}, Actual: Shape{rows, cols}}
 		}
+	default:
+		return nil, dim.validate()
 	}
 	return dimFn(dim, count, m, fn), nil
 }
 
+// validMatrix returns error if matrix m is nil or has zero size
+func validMatrix(m mat.Matrix) error {
+	// matrix can't be nil
+	if isNil(m) {
+		return fmt.Errorf("%w: %v", ErrNilMatrix, m)
+	}
+	if rows, cols := m.Dims(); rows == 0 || cols == 0 {
+		return ErrZeroSize
+	}
+	return nil
+}
+
+// isNil returns true if m is nil or if it is a nil pointer to a matrix
+func isNil(m mat.Matrix) bool {
+	if m == nil {
+		return true
+	}
+	v := reflect.ValueOf(m)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// newRand returns a random number generator which draws numbers from src.
+// If src is already a *rand.Rand it is returned as is. If src is nil
+// a new source seeded with the current time is used.
+func newRand(src rand.Source) *rand.Rand {
+	switch s := src.(type) {
+	case nil:
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	case *rand.Rand:
+		return s
+	default:
+		return rand.New(s)
+	}
+}
+
 // withValidDims validates if the rows and cols are valid matrix dimensions
 // It returns error if either rows or cols are invalid i.e. non-positive integers
 func withValidDims(rows, cols int, fn func() (*mat.Dense, error)) (*mat.Dense, error) {
 	// can not create matrix with negative dimensions
 	if rows <= 0 {
-		return nil, fmt.Errorf("invalid number of rows: %d", rows)
+		return nil, fmt.Errorf("%w: invalid number of rows: %d", ErrZeroSize, rows)
 	}
 	if cols <= 0 {
-		return nil, fmt.Errorf("invalid number of columns: %d", cols)
+		return nil, fmt.Errorf("%w: invalid number of columns: %d", ErrZeroSize, cols)
 	}
 	return fn()
 }
@@ -211,25 +324,57 @@ func mean(m mat.Matrix) float64 {
 	return mat.Sum(m) / (float64(r) * float64(c))
 }
 
-// returns a mean valur for a given matrix
+// returns a sample standard deviation of all elements of a given matrix
 func stdev(m mat.Matrix) float64 {
-	r, _ := m.Dims()
-	col := make([]float64, r)
-	mat.Col(col, 0, m)
-	return stat.StdDev(col, nil)
+	return math.Sqrt(variance(m, 1, nil))
 }
 
 // Cov calculates a covariance matrix with data stored in m along dim dimension.
-// It returns error if the covariance could not be calculated.
-func Cov(m *mat.Dense, dim string) (*mat.SymDense, error) {
+// dim must be either "rows" or "cols". For "cols" it returns the same matrix as CovDim.
+// For "rows" it keeps its original behaviour: it centres the columns of m and returns
+// the rows x rows matrix x * x^T / (rows-1) of the centred data x. Use CovDim with Rows
+// to get the covariance of the matrix columns.
+// It returns error if dim is invalid or the covariance could not be calculated.
+func Cov(m mat.Matrix, dim string) (*mat.SymDense, error) {
+	d, err := ParseDim(dim)
+	if err != nil {
+		return nil, err
+	}
+	return covariance(m, d, true)
+}
+
+// CovDim calculates a covariance matrix with data stored in m along dim dimension.
+// If dim is Cols, each matrix row is a variable whose observations are stored in columns
+// and the returned matrix has the size of the number of rows. If dim is Rows, each matrix
+// column is a variable whose observations are stored in rows and the returned matrix has
+// the size of the number of columns.
+// It returns error if dim is invalid or the covariance could not be calculated.
+func CovDim(m mat.Matrix, dim Dim) (*mat.SymDense, error) {
+	return covariance(m, dim, false)
+}
+
+// covariance calculates a covariance matrix with data stored in m along dim dimension.
+// If rowsGram is true and dim is Rows, it returns x * x^T of the column centred data x
+// as Cov always did, instead of the covariance of the matrix columns.
+func covariance(m mat.Matrix, dim Dim, rowsGram bool) (*mat.SymDense, error) {
+	if isNil(m) {
+		return nil, fmt.Errorf("%w: %v", ErrNilMatrix, m)
+	}
+	if err := dim.validate(); err != nil {
+		return nil, err
+	}
+
 	// 1. We will calculate zero mean matrix x of the data
-	// 2. 1/(n-1)(x * x^T) will give us covariance of the data
+	// 2. 1/(n-1)(x * x^T) or 1/(n-1)(x^T * x) will give us covariance of the data
 	rows, cols := m.Dims()
+	if rows == 0 || cols == 0 {
+		return nil, ErrZeroSize
+	}
 
 	// calculate mean data vector across dimension dim
 	var mean []float64
 	var count float64
-	if strings.EqualFold(dim, "cols") {
+	if dim == Cols {
 		mean, _ = RowsMean(rows, m)
 		count = float64(cols)
 	} else {
@@ -241,7 +386,7 @@ func Cov(m *mat.Dense, dim string) (*mat.SymDense, error) {
 	x := mat.NewDense(rows, cols, nil)
 	for r := 0; r < rows; r++ {
 		for c := 0; c < cols; c++ {
-			if strings.EqualFold(dim, "cols") {
+			if dim == Cols {
 				x.Set(r, c, m.At(r, c)-mean[r])
 			} else {
 				x.Set(r, c, m.At(r, c)-mean[c])
@@ -250,18 +395,31 @@ func Cov(m *mat.Dense, dim string) (*mat.SymDense, error) {
 	}
 
 	cov := new(mat.Dense)
-	cov.Mul(x, x.T())
+	if dim == Cols || rowsGram {
+		cov.Mul(x, x.T())
+	} else {
+		cov.Mul(x.T(), x)
+	}
 	cov.Scale(1/(count-1.0), cov)
 
 	return ToSymDense(cov)
 }
 
 // ToSymDense converts m to SymDense (symmetric Dense matrix) if possible.
-// It returns error if the provided Dense matrix is not symmetric.
-func ToSymDense(m *mat.Dense) (*mat.SymDense, error) {
+// It returns error if the provided matrix is nil, not square or not symmetric.
+func ToSymDense(m mat.Matrix) (*mat.SymDense, error) {
+	if isNil(m) {
+		return nil, fmt.Errorf("%w: %v", ErrNilMatrix, m)
+	}
 	r, c := m.Dims()
 	if r != c {
-		return nil, errors.New("Matrix must be square")
+		return nil, fmt.Errorf("%w: %dx%d", ErrNotSquare, r, c)
+	}
+
+	if s, ok := m.(mat.Symmetric); ok {
+		sym := mat.NewSymDense(r, nil)
+		sym.CopySym(s)
+		return sym, nil
 	}
 
 	mT := m.T()
@@ -270,7 +428,7 @@ func ToSymDense(m *mat.Dense) (*mat.SymDense, error) {
 	for i := 0; i < r; i++ {
 		for j := 0; j < c; j++ {
 			if i != j && !scalar.EqualWithinAbsOrRel(mT.At(i, j), m.At(i, j), 1e-6, 1e-2) {
-				return nil, fmt.Errorf("Matrix not symmetric (%d, %d): %.40f != %.40f\n%v",
+				return nil, fmt.Errorf("%w (%d, %d): %.40f != %.40f\n%v", ErrNotSymmetric,
 					i, j, mT.At(i, j), m.At(i, j), Format(m))
 			}
 			vals[idx] = m.At(i, j)
@@ -319,7 +477,7 @@ func BlockSymDiag(mx []mat.Symmetric) *mat.SymDense {
 
 // Unroll unrolls all elements of matrix into *mat.VecDense and returns it
 // Matrix elements can be unrolled either by row or by a column.
-func Unroll(m *mat.Dense, byRow bool) *mat.VecDense {
+func Unroll(m mat.Matrix, byRow bool) *mat.VecDense {
 	if byRow {
 		return toVecByRow(m)
 	}
@@ -327,28 +485,22 @@ func Unroll(m *mat.Dense, byRow bool) *mat.VecDense {
 }
 
 // toVecByRow rolls matrix into a slice by rows
-func toVecByRow(m *mat.Dense) *mat.VecDense {
+func toVecByRow(m mat.Matrix) *mat.VecDense {
 	rows, cols := m.Dims()
 	vec := make([]float64, rows*cols)
 	for i := 0; i < rows; i++ {
-		view := m.RowView(i)
-		for j := 0; j < view.Len(); j++ {
-			vec[i*cols+j] = view.At(j, 0)
-		}
+		mat.Row(vec[i*cols:(i+1)*cols], i, m)
 	}
 
 	return mat.NewVecDense(rows*cols, vec)
 }
 
 // toVecByCol rolls matrix into a slice by columns
-func toVecByCol(m *mat.Dense) *mat.VecDense {
+func toVecByCol(m mat.Matrix) *mat.VecDense {
 	rows, cols := m.Dims()
 	vec := make([]float64, rows*cols)
 	for i := 0; i < cols; i++ {
-		view := m.ColView(i)
-		for j := 0; j < view.Len(); j++ {
-			vec[i*rows+j] = view.At(j, 0)
-		}
+		mat.Col(vec[i*rows:(i+1)*rows], i, m)
 	}
 
 	return mat.NewVecDense(rows*cols, vec)
@@ -358,9 +510,13 @@ func toVecByCol(m *mat.Dense) *mat.VecDense {
 // passed in as a parameter. It fails with error if number of elements
 // of the matrix is bigger than number of elements of the slice.
 func SetVals(m *mat.Dense, vals []float64, byRow bool) (err error) {
+	if m == nil {
+		err = fmt.Errorf("%w: %v", ErrNilMatrix, m)
+		return
+	}
 	r, c := m.Dims()
 	if r*c != len(vals) {
-		err = fmt.Errorf("elements count mismatch: Vec: %d, Matrix: %d", len(vals), r*c)
+		err = fmt.Errorf("%w: elements count mismatch: Vec: %d, Matrix: %d", ErrDimMismatch, len(vals), r*c)
 		return
 	}
 	if byRow {
@@ -1,18 +1,19 @@
 package matrix
 
 import (
+	"errors"
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
 )
 
 var (
-	errInvMx   = "invalid matrix supplied: %v"
-	errExcCols = "column count exceeds matrix columns: %d"
-	errExcrows = "row count exceeds matrix rows: %d"
+	errInvMx = "invalid matrix supplied: %v"
 )
 
 func TestFormat(t *testing.T) {
@@ -58,6 +59,44 @@ func TestNewRandDense(t *testing.T) {
 	assert.Error(err)
 }
 
+func TestNewRandDenseSrc(t *testing.T) {
+	assert := assert.New(t)
+
+	rows, cols := 3, 4
+	min, max := -1.0, 1.0
+
+	// same seed produces the same matrix
+	m1, err := NewDenseRandSrc(rows, cols, min, max, rand.NewSource(1))
+	assert.NoError(err)
+	m2, err := NewDenseRandSrc(rows, cols, min, max, rand.New(rand.NewSource(1)))
+	assert.NoError(err)
+	assert.True(mat.Equal(m1, m2))
+
+	// different seeds produce different matrices
+	m3, err := NewDenseRandSrc(rows, cols, min, max, rand.NewSource(2))
+	assert.NoError(err)
+	assert.False(mat.Equal(m1, m3))
+	assert.True(max >= mat.Max(m3))
+	assert.True(min <= mat.Min(m3))
+
+	// nil source falls back to a time seeded source
+	m4, err := NewDenseRandSrc(rows, cols, min, max, nil)
+	assert.NotNil(m4)
+	assert.NoError(err)
+
+	// NewDenseRand is deterministic
+	m5, err := NewDenseRand(rows, cols, min, max)
+	assert.NoError(err)
+	m6, err := NewDenseRand(rows, cols, min, max)
+	assert.NoError(err)
+	assert.True(mat.Equal(m5, m6))
+
+	// Can't create new matrix
+	m1, err = NewDenseRandSrc(rows, 0, min, max, rand.NewSource(1))
+	assert.Nil(m1)
+	assert.Error(err)
+}
+
 func TestNewConstDense(t *testing.T) {
 	assert := assert.New(t)
 
@@ -109,6 +148,12 @@ func TestAddConst(t *testing.T) {
 	assert.NoError(err)
 	assert.True(mat.EqualApprox(mx, mc, 0.01))
 
+	// views of a matrix modify the original matrix
+	view := mc.Slice(0, 2, 1, 2).(*mat.Dense)
+	_, err = AddVal(view, val)
+	assert.NoError(err)
+	assert.Equal([]float64{1.5, 3.0, 3.0, 3.5}, mc.RawMatrix().Data)
+
 	// incorrect matrix passed in
 	mx, err = AddVal(nil, val)
 	assert.Nil(mx)
@@ -140,21 +185,23 @@ func TestRowsColsMax(t *testing.T) {
 	// requested number of cols exceeds matrix dims
 	max, err = ColsMax(cols+1, mx)
 	assert.Nil(max)
-	assert.EqualError(err, fmt.Sprintf(errExcCols, cols+1))
+	assert.Equal(&DimError{Expected: Shape{rows, cols + 1}, Actual: Shape{rows, cols}}, err)
 
 	// requested number of rows exceeds matrix dims
 	max, err = RowsMax(rows+1, mx)
 	assert.Nil(max)
-	assert.EqualError(err, fmt.Sprintf(errExcrows, rows+1))
+	assert.Equal(&DimError{Expected: Shape{rows + 1, cols}, Actual: Shape{rows, cols}}, err)
 
 	// should get nil back
 	mx = nil
 	max, err = ColsMax(cols, mx)
 	assert.Nil(max)
 	assert.EqualError(err, fmt.Sprintf(errInvMx, mx))
+	assert.True(errors.Is(err, ErrNilMatrix))
 	max, err = RowsMax(rows, mx)
 	assert.Nil(max)
 	assert.EqualError(err, fmt.Sprintf(errInvMx, mx))
+	assert.True(errors.Is(err, ErrNilMatrix))
 }
 
 func TestRowsColsMin(t *testing.T) {
@@ -182,21 +229,23 @@ func TestRowsColsMin(t *testing.T) {
 	// requested number of cols exceeds matrix dims
 	min, err = ColsMin(cols+1, mx)
 	assert.Nil(min)
-	assert.EqualError(err, fmt.Sprintf(errExcCols, cols+1))
+	assert.Equal(&DimError{Expected: Shape{rows, cols + 1}, Actual: Shape{rows, cols}}, err)
 
 	// requested number of rows exceeds matrix dims
 	min, err = RowsMin(rows+1, mx)
 	assert.Nil(min)
-	assert.EqualError(err, fmt.Sprintf(errExcrows, rows+1))
+	assert.Equal(&DimError{Expected: Shape{rows + 1, cols}, Actual: Shape{rows, cols}}, err)
 
 	// should get nil back
 	mx = nil
 	min, err = ColsMin(cols, mx)
 	assert.Nil(min)
 	assert.EqualError(err, fmt.Sprintf(errInvMx, mx))
+	assert.True(errors.Is(err, ErrNilMatrix))
 	min, err = RowsMin(rows, mx)
 	assert.Nil(min)
 	assert.EqualError(err, fmt.Sprintf(errInvMx, mx))
+	assert.True(errors.Is(err, ErrNilMatrix))
 }
 
 func TestRowsColsSums(t *testing.T) {
@@ -264,6 +313,142 @@ func TestColsStdev(t *testing.T) {
 	assert.True(floats.EqualApprox(colsStdev, sd, 0.01))
 }
 
+func TestRowsStdev(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{1.2, 3.4, 5.6, 4.5, 6.7, 7.1}
+	mx := mat.NewDense(2, 3, data)
+	assert.NotNil(mx)
+	rowsStdev := []float64{2.2, 1.4}
+
+	// check rows
+	rows, _ := mx.Dims()
+	sd, err := RowsStdev(rows, mx)
+	assert.NotNil(sd)
+	assert.NoError(err)
+	assert.True(floats.EqualApprox(rowsStdev, sd, 0.01))
+
+	// rows of a transposed matrix are its columns
+	sd, err = RowsStdev(rows, mx.T().T())
+	assert.NoError(err)
+	assert.True(floats.EqualApprox(rowsStdev, sd, 0.01))
+
+	sd, err = RowsStdev(rows+1, mx)
+	assert.Nil(sd)
+	assert.Error(err)
+}
+
+func TestDimReductions(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{1.2, 3.4, 4.5, 6.7, 8.9, 10.0}
+	mx := mat.NewDense(3, 2, data)
+	rows, cols := mx.Dims()
+
+	fns := []struct {
+		dimFn  func(Dim, int, mat.Matrix) ([]float64, error)
+		rowsFn func(int, mat.Matrix) ([]float64, error)
+		colsFn func(int, mat.Matrix) ([]float64, error)
+	}{
+		{Sum, RowsSum, ColsSum},
+		{Max, RowsMax, ColsMax},
+		{Min, RowsMin, ColsMin},
+		{Mean, RowsMean, ColsMean},
+	}
+
+	for _, fn := range fns {
+		res, err := fn.dimFn(Rows, rows, mx)
+		assert.NoError(err)
+		exp, _ := fn.rowsFn(rows, mx)
+		assert.Equal(exp, res)
+
+		res, err = fn.dimFn(Cols, cols, mx)
+		assert.NoError(err)
+		exp, _ = fn.colsFn(cols, mx)
+		assert.Equal(exp, res)
+
+		res, err = fn.dimFn(Dim(10), 1, mx)
+		assert.Nil(res)
+		assert.Error(err)
+	}
+}
+
+func TestMatrixTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12,
+		13, 14, 15, 16,
+	}
+	d := mat.NewDense(4, 4, data)
+	sym := mat.NewSymDense(3, []float64{1, 2, 3, 2, 4, 5, 3, 5, 6})
+	tri := mat.NewTriDense(3, mat.Upper, []float64{1, 2, 3, 0, 4, 5, 0, 0, 6})
+	band := mat.NewBandDense(3, 3, 1, 0, []float64{0, 1, 2, 3, 4, 5})
+
+	mx := []mat.Matrix{
+		d,
+		d.Slice(1, 3, 1, 4),
+		d.T(),
+		d.Slice(1, 3, 1, 4).T(),
+		sym,
+		tri,
+		band,
+		band.T(),
+		mat.NewVecDense(3, []float64{1, 2, 3}),
+	}
+
+	for _, m := range mx {
+		r, c := m.Dims()
+		// compare against a Dense copy of the matrix
+		dm := mat.DenseCopyOf(m)
+
+		for _, dim := range []Dim{Rows, Cols} {
+			count := r
+			if dim == Cols {
+				count = c
+			}
+			for _, fn := range []func(Dim, int, mat.Matrix) ([]float64, error){Sum, Max, Min, Mean} {
+				res, err := fn(dim, count, m)
+				assert.NoError(err)
+				exp, err := fn(dim, count, dm)
+				assert.NoError(err)
+				assert.Equal(exp, res)
+			}
+		}
+
+		sd, err := ColsStdev(c, m)
+		assert.NoError(err)
+		exp, _ := ColsStdev(c, dm)
+		assert.Equal(exp, sd)
+
+		assert.Equal(Unroll(dm, true), Unroll(m, true))
+		assert.Equal(Unroll(dm, false), Unroll(m, false))
+	}
+
+	// symmetric matrices are converted directly
+	s, err := ToSymDense(sym)
+	assert.NoError(err)
+	assert.True(mat.Equal(sym, s))
+
+	cov, err := CovDim(d.T(), Rows)
+	assert.NoError(err)
+	exp, _ := CovDim(mat.DenseCopyOf(d.T()), Rows)
+	assert.True(mat.Equal(exp, cov))
+
+	// typed nil matrix
+	var nilMx *mat.SymDense
+	res, err := Sum(Rows, 1, nilMx)
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	// zero size matrix
+	res, err = Sum(Rows, 1, &mat.SymDense{})
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrZeroSize))
+}
+
 func TestCov(t *testing.T) {
 	assert := assert.New(t)
 	data := []float64{1, 2, 2, 4}
@@ -295,6 +480,42 @@ func TestCov(t *testing.T) {
 			assert.InDelta(colCov.At(r, c), cov.At(r, c), delta)
 		}
 	}
+
+	// CovDim with Rows returns covariance of the columns
+	cov, err = CovDim(m, Rows)
+	assert.NoError(err)
+	assert.True(mat.EqualApprox(mat.NewDense(2, 2, []float64{0.5, 1.0, 1.0, 2.0}), cov, delta))
+
+	cov, err = CovDim(m, Cols)
+	assert.NoError(err)
+	assert.True(mat.EqualApprox(colCov, cov, delta))
+
+	// observations stored in rows give covariance of columns
+	m = mat.NewDense(4, 3, []float64{
+		1, 2, 0,
+		2, 4, 1,
+		3, 5, 0,
+		6, 1, 1,
+	})
+	cov, err = CovDim(m, Rows)
+	assert.NoError(err)
+	assert.Equal(3, cov.SymmetricDim())
+	exp := &mat.SymDense{}
+	stat.CovarianceMatrix(exp, m, nil)
+	assert.True(mat.EqualApprox(exp, cov, 1e-12))
+
+	cov, err = CovDim(m.T(), Cols)
+	assert.NoError(err)
+	assert.True(mat.EqualApprox(exp, cov, 1e-12))
+
+	// invalid dimensions
+	cov, err = Cov(m, "colls")
+	assert.Nil(cov)
+	assert.Error(err)
+
+	cov, err = CovDim(m, Dim(-1))
+	assert.Nil(cov)
+	assert.Error(err)
 }
 
 func TestToSymDense(t *testing.T) {
@@ -306,11 +527,15 @@ func TestToSymDense(t *testing.T) {
 
 	sym, err := ToSymDense(badMx)
 	assert.Nil(sym)
-	assert.Error(err)
+	assert.True(errors.Is(err, ErrNotSquare))
 
 	sym, err = ToSymDense(notSymMx)
 	assert.Nil(sym)
-	assert.Error(err)
+	assert.True(errors.Is(err, ErrNotSymmetric))
+
+	sym, err = ToSymDense(nil)
+	assert.Nil(sym)
+	assert.True(errors.Is(err, ErrNilMatrix))
 
 	sym, err = ToSymDense(symMx)
 	assert.NotNil(sym)
@@ -418,5 +643,8 @@ func TestSetVals(t *testing.T) {
 	// Vector is smaller than number of matrix elements
 	shortVec := []float64{1.3, 2.4}
 	err = SetVals(mx, shortVec, true)
-	assert.Error(err)
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	err = SetVals(nil, data, true)
+	assert.True(errors.Is(err, ErrNilMatrix))
 }
@@ -0,0 +1,142 @@
+package matrix
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// NanCount returns a slice of numbers of non-NaN values in first count matrix rows or columns
+// as selected by dim. It returns error if passed in matrix is nil, has zero size, dim is invalid
+// or requested count exceeds the size of the matrix dimension.
+func NanCount(dim Dim, count int, m mat.Matrix) ([]int, error) {
+	res, err := withValidDim(dim, count, m, func(v mat.Matrix) float64 {
+		n, _, _ := nanMoments(values(v))
+		return n
+	})
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]int, len(res))
+	for i := range res {
+		counts[i] = int(res[i])
+	}
+	return counts, nil
+}
+
+// NanSum returns a slice of sums of first count matrix rows or columns as selected by dim
+// ignoring NaN values. The sum of a row or column which contains only NaN values is zero.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or requested
+// count exceeds the size of the matrix dimension.
+func NanSum(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, func(v mat.Matrix) float64 {
+		var sum float64
+		for _, x := range values(v) {
+			if !math.IsNaN(x) {
+				sum += x
+			}
+		}
+		return sum
+	})
+}
+
+// NanMean returns a slice of means of first count matrix rows or columns as selected by dim
+// ignoring NaN values. The mean of a row or column which contains only NaN values is NaN.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or requested
+// count exceeds the size of the matrix dimension.
+func NanMean(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, func(v mat.Matrix) float64 {
+		n, mu, _ := nanMoments(values(v))
+		if n == 0 {
+			return math.NaN()
+		}
+		return mu
+	})
+}
+
+// NanStdev returns a slice of sample standard deviations of first count matrix rows or columns
+// as selected by dim ignoring NaN values. The standard deviation of a row or column which contains
+// fewer than two non-NaN values is NaN.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or requested
+// count exceeds the size of the matrix dimension.
+func NanStdev(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, func(v mat.Matrix) float64 {
+		n, _, ss := nanMoments(values(v))
+		if n < 2 {
+			return math.NaN()
+		}
+		return math.Sqrt(ss / (n - 1))
+	})
+}
+
+// NanCov calculates a sample covariance matrix of data stored in m along dim dimension
+// ignoring NaN values. If dim is Cols, each matrix row is a variable whose observations are
+// stored in columns; if dim is Rows, each matrix column is a variable whose observations are
+// stored in rows. The covariance of every pair of variables is calculated from the
+// pairwise-complete observations i.e. the observations where neither of the variables is NaN.
+// The covariance of variables with fewer than two pairwise-complete observations is NaN.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func NanCov(m mat.Matrix, dim Dim) (*mat.SymDense, error) {
+	// variables are the matrix rows if observations are stored along columns and vice versa
+	varDim := Cols
+	if dim == Cols {
+		varDim = Rows
+	}
+	n, err := dimLen(varDim, m)
+	if err != nil {
+		return nil, err
+	}
+	if err := dim.validate(); err != nil {
+		return nil, err
+	}
+
+	vars := make([][]float64, n)
+	view := dimView(varDim, m)
+	for i := range vars {
+		vars[i] = append([]float64(nil), values(view(i))...)
+	}
+
+	cov := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			cov.SetSym(i, j, nanPairCov(vars[i], vars[j]))
+		}
+	}
+
+	return cov, nil
+}
+
+// nanMoments returns the number of non-NaN values in x, their mean and the sum of squared
+// deviations from the mean. It uses Welford's online algorithm.
+func nanMoments(x []float64) (n, mean, ss float64) {
+	for _, v := range x {
+		if math.IsNaN(v) {
+			continue
+		}
+		n++
+		d := v - mean
+		mean += d / n
+		ss += d * (v - mean)
+	}
+	return n, mean, ss
+}
+
+// nanPairCov returns sample covariance of x and y calculated from the observations
+// where neither x nor y is NaN. It returns NaN if there are fewer than two such observations.
+func nanPairCov(x, y []float64) float64 {
+	var n, mx, my, c float64
+	for i := range x {
+		if math.IsNaN(x[i]) || math.IsNaN(y[i]) {
+			continue
+		}
+		n++
+		dx := x[i] - mx
+		mx += dx / n
+		my += (y[i] - my) / n
+		c += dx * (y[i] - my)
+	}
+	if n < 2 {
+		return math.NaN()
+	}
+	return c / (n - 1)
+}
@@ -0,0 +1,122 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func TestNanReductions(t *testing.T) {
+	assert := assert.New(t)
+
+	nan := math.NaN()
+	data := []float64{
+		1.0, nan, nan,
+		2.0, 4.0, nan,
+		nan, 6.0, nan,
+		3.0, 8.0, nan,
+	}
+	mx := mat.NewDense(4, 3, data)
+	rows, cols := mx.Dims()
+
+	count, err := NanCount(Cols, cols, mx)
+	assert.NoError(err)
+	assert.Equal([]int{3, 3, 0}, count)
+
+	count, err = NanCount(Rows, rows, mx)
+	assert.NoError(err)
+	assert.Equal([]int{1, 2, 1, 2}, count)
+
+	sum, err := NanSum(Cols, cols, mx)
+	assert.NoError(err)
+	assert.Equal([]float64{6.0, 18.0, 0.0}, sum)
+
+	mean, err := NanMean(Cols, cols, mx)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{2.0, 6.0}, mean[:2], 1e-12)
+	assert.True(math.IsNaN(mean[2]))
+
+	sd, err := NanStdev(Cols, cols, mx)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{1.0, 2.0}, sd[:2], 1e-12)
+	assert.True(math.IsNaN(sd[2]))
+
+	sd, err = NanStdev(Rows, rows, mx)
+	assert.NoError(err)
+	assert.True(math.IsNaN(sd[0]))
+	assert.InDelta(math.Sqrt(2), sd[1], 1e-12)
+
+	// without NaNs the results match the regular reductions
+	full := mat.NewDense(3, 2, []float64{1.2, 3.4, 4.5, 6.7, 8.9, 10.0})
+	nanSum, _ := NanSum(Rows, 3, full)
+	expSum, _ := RowsSum(3, full)
+	assert.InDeltaSlice(expSum, nanSum, 1e-12)
+	nanMean, _ := NanMean(Cols, 2, full)
+	expMean, _ := ColsMean(2, full)
+	assert.InDeltaSlice(expMean, nanMean, 1e-12)
+	nanSd, _ := NanStdev(Cols, 2, full)
+	expSd, _ := ColsStdev(2, full)
+	assert.InDeltaSlice(expSd, nanSd, 1e-12)
+
+	for _, fn := range []func(Dim, int, mat.Matrix) ([]float64, error){NanSum, NanMean, NanStdev} {
+		res, err := fn(Cols, cols+1, mx)
+		assert.Nil(res)
+		assert.True(errors.Is(err, ErrDimMismatch))
+	}
+
+	count, err = NanCount(Rows, 1, nil)
+	assert.Nil(count)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
+
+func TestNanCov(t *testing.T) {
+	assert := assert.New(t)
+
+	// without NaNs the results match the regular covariance
+	data := []float64{
+		1.2, 3.4, 5.6,
+		4.5, 6.7, 7.1,
+		8.9, 10.0, 2.3,
+		0.5, -1.0, 4.4,
+	}
+	mx := mat.NewDense(4, 3, data)
+
+	cov, err := NanCov(mx, Cols)
+	assert.NoError(err)
+	exp, _ := CovDim(mx, Cols)
+	assert.True(mat.EqualApprox(exp, cov, 1e-12))
+
+	cov, err = NanCov(mx, Rows)
+	assert.NoError(err)
+	exp, _ = CovDim(mx, Rows)
+	assert.True(mat.EqualApprox(exp, cov, 1e-12))
+
+	// pairwise-complete observations
+	nan := math.NaN()
+	mx = mat.NewDense(4, 3, []float64{
+		1.0, 2.0, nan,
+		2.0, nan, 1.0,
+		3.0, 6.0, nan,
+		nan, 8.0, 3.0,
+	})
+	cov, err = NanCov(mx, Rows)
+	assert.NoError(err)
+	assert.InDelta(1.0, cov.At(0, 0), 1e-12)
+	assert.InDelta(stat.Variance([]float64{2, 6, 8}, nil), cov.At(1, 1), 1e-12)
+	assert.InDelta(2.0, cov.At(2, 2), 1e-12)
+	assert.InDelta(stat.Covariance([]float64{1, 3}, []float64{2, 6}, nil), cov.At(0, 1), 1e-12)
+	assert.True(math.IsNaN(cov.At(0, 2)))
+	assert.True(math.IsNaN(cov.At(1, 2)))
+
+	cov, err = NanCov(mx, Dim(7))
+	assert.Nil(cov)
+	assert.True(errors.Is(err, ErrInvalidDim))
+
+	cov, err = NanCov(nil, Rows)
+	assert.Nil(cov)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
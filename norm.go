@@ -0,0 +1,104 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ColsNorm returns a slice of p-norms of first cols matrix columns.
+// p can be any positive number: 1 gives L1 norm, 2 gives Euclidean norm and math.Inf(1) gives max norm.
+// It returns error if passed in matrix is nil or has zero size, requested number of columns
+// exceeds the number of columns in matrix m or p is not positive.
+func ColsNorm(cols int, m mat.Matrix, p float64) ([]float64, error) {
+	return Norm(Cols, cols, m, p)
+}
+
+// RowsNorm returns a slice of p-norms of first rows matrix rows.
+// p can be any positive number: 1 gives L1 norm, 2 gives Euclidean norm and math.Inf(1) gives max norm.
+// It returns error if passed in matrix is nil or has zero size, requested number of rows
+// exceeds the number of rows in matrix m or p is not positive.
+func RowsNorm(rows int, m mat.Matrix, p float64) ([]float64, error) {
+	return Norm(Rows, rows, m, p)
+}
+
+// Norm returns a slice of p-norms of first count matrix rows or columns as selected by dim.
+// p can be any positive number: 1 gives L1 norm, 2 gives Euclidean norm and math.Inf(1) gives max norm.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid, requested count exceeds
+// the size of the matrix dimension or p is not positive.
+func Norm(dim Dim, count int, m mat.Matrix, p float64) ([]float64, error) {
+	if err := validNormOrder(p); err != nil {
+		return nil, err
+	}
+	return withValidDim(dim, count, m, func(v mat.Matrix) float64 {
+		return norm(v, p)
+	})
+}
+
+// NormalizeCols scales each column of matrix m in place to unit p-norm.
+// Columns whose norm is zero are left unchanged.
+// It returns error if passed in matrix is nil, has zero size or p is not positive.
+func NormalizeCols(m *mat.Dense, p float64) error {
+	return Normalize(Cols, m, p)
+}
+
+// NormalizeRows scales each row of matrix m in place to unit p-norm.
+// Rows whose norm is zero are left unchanged.
+// It returns error if passed in matrix is nil, has zero size or p is not positive.
+func NormalizeRows(m *mat.Dense, p float64) error {
+	return Normalize(Rows, m, p)
+}
+
+// Normalize scales each row or column of matrix m as selected by dim in place to unit p-norm.
+// Rows or columns whose norm is zero are left unchanged.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or p is not positive.
+func Normalize(dim Dim, m *mat.Dense, p float64) error {
+	if err := validNormOrder(p); err != nil {
+		return err
+	}
+	return normalize(dim, m, func(v mat.Matrix) float64 {
+		return norm(v, p)
+	})
+}
+
+// NormalizeSum scales each row or column of matrix m as selected by dim in place so that
+// its elements sum to one. Rows or columns whose sum is zero are left unchanged.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func NormalizeSum(dim Dim, m *mat.Dense) error {
+	return normalize(dim, m, mat.Sum)
+}
+
+// normalize divides each row or column of m as selected by dim by the non-zero result of fn applied to it
+func normalize(dim Dim, m *mat.Dense, fn ReduceFunc) error {
+	n, err := dimLen(dim, m)
+	if err != nil {
+		return err
+	}
+	view := dimView(dim, m)
+	for i := 0; i < n; i++ {
+		v := view(i).(*mat.VecDense)
+		if s := fn(v); s != 0 {
+			v.ScaleVec(1/s, v)
+		}
+	}
+	return nil
+}
+
+// validNormOrder returns error if p is not a valid norm order
+func validNormOrder(p float64) error {
+	if !(p > 0) {
+		return fmt.Errorf("invalid norm order: %f", p)
+	}
+	return nil
+}
+
+// norm returns p-norm of vector v
+func norm(v mat.Matrix, p float64) float64 {
+	switch p {
+	case 1, 2, math.Inf(1):
+		return mat.Norm(v, p)
+	}
+	return floats.Norm(values(v), p)
+}
@@ -0,0 +1,113 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestNorm(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		3.0, -4.0,
+		-6.0, 8.0,
+		0.0, 1.0,
+	}
+	mx := mat.NewDense(3, 2, data)
+	rows, cols := mx.Dims()
+
+	tests := []struct {
+		p    float64
+		rows []float64
+		cols []float64
+	}{
+		{1.0, []float64{7.0, 14.0, 1.0}, []float64{9.0, 13.0}},
+		{2.0, []float64{5.0, 10.0, 1.0}, []float64{math.Sqrt(45.0), 9.0}},
+		{math.Inf(1), []float64{4.0, 8.0, 1.0}, []float64{6.0, 8.0}},
+		{3.0, []float64{math.Cbrt(91.0), math.Cbrt(728.0), 1.0}, []float64{math.Cbrt(243.0), math.Cbrt(577.0)}},
+	}
+
+	for _, tc := range tests {
+		n, err := RowsNorm(rows, mx, tc.p)
+		assert.NoError(err)
+		assert.InDeltaSlice(tc.rows, n, 1e-12)
+
+		n, err = ColsNorm(cols, mx, tc.p)
+		assert.NoError(err)
+		assert.InDeltaSlice(tc.cols, n, 1e-12)
+
+		// transposed matrix swaps rows and columns
+		n, err = Norm(Rows, cols, mx.T(), tc.p)
+		assert.NoError(err)
+		assert.InDeltaSlice(tc.cols, n, 1e-12)
+	}
+
+	n, err := ColsNorm(1, mx, 2.0)
+	assert.NoError(err)
+	assert.Len(n, 1)
+
+	for _, p := range []float64{0.0, -1.0, math.NaN()} {
+		n, err = RowsNorm(rows, mx, p)
+		assert.Nil(n)
+		assert.Error(err)
+	}
+
+	n, err = ColsNorm(cols+1, mx, 2.0)
+	assert.Nil(n)
+	assert.True(errors.Is(err, ErrDimMismatch))
+}
+
+func TestNormalize(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		3.0, -4.0,
+		0.0, 0.0,
+		6.0, 8.0,
+	}
+
+	mx := mat.NewDense(3, 2, append([]float64(nil), data...))
+	assert.NoError(NormalizeRows(mx, 2.0))
+	exp := mat.NewDense(3, 2, []float64{0.6, -0.8, 0.0, 0.0, 0.6, 0.8})
+	assert.True(mat.EqualApprox(exp, mx, 1e-12))
+
+	mx = mat.NewDense(3, 2, append([]float64(nil), data...))
+	assert.NoError(NormalizeCols(mx, 1.0))
+	exp = mat.NewDense(3, 2, []float64{1.0 / 3, -1.0 / 3, 0.0, 0.0, 2.0 / 3, 2.0 / 3})
+	assert.True(mat.EqualApprox(exp, mx, 1e-12))
+
+	mx = mat.NewDense(3, 2, append([]float64(nil), data...))
+	assert.NoError(Normalize(Rows, mx, math.Inf(1)))
+	exp = mat.NewDense(3, 2, []float64{0.75, -1.0, 0.0, 0.0, 0.75, 1.0})
+	assert.True(mat.EqualApprox(exp, mx, 1e-12))
+
+	// normalizing a slice of a matrix only changes the sliced elements
+	mx = mat.NewDense(3, 2, append([]float64(nil), data...))
+	assert.NoError(NormalizeCols(mx.Slice(0, 3, 1, 2).(*mat.Dense), math.Inf(1)))
+	exp = mat.NewDense(3, 2, []float64{3.0, -0.5, 0.0, 0.0, 6.0, 1.0})
+	assert.True(mat.EqualApprox(exp, mx, 1e-12))
+
+	mx = mat.NewDense(2, 3, []float64{1.0, 2.0, 1.0, -1.0, 1.0, 0.0})
+	assert.NoError(NormalizeSum(Rows, mx))
+	exp = mat.NewDense(2, 3, []float64{0.25, 0.5, 0.25, -1.0, 1.0, 0.0})
+	assert.True(mat.EqualApprox(exp, mx, 1e-12))
+
+	mx = mat.NewDense(2, 2, []float64{1.0, 2.0, 3.0, 2.0})
+	assert.NoError(NormalizeSum(Cols, mx))
+	exp = mat.NewDense(2, 2, []float64{0.25, 0.5, 0.75, 0.5})
+	assert.True(mat.EqualApprox(exp, mx, 1e-12))
+
+	var nilMx *mat.Dense
+	err := NormalizeRows(nilMx, 2.0)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	err = NormalizeRows(mx, 0.0)
+	assert.Error(err)
+
+	err = NormalizeSum(Dim(5), mx)
+	assert.True(errors.Is(err, ErrInvalidDim))
+}
@@ -0,0 +1,306 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Rander generates random numbers from a probability distribution.
+// All univariate distributions in gonum stat/distuv package implement it.
+type Rander interface {
+	// Rand returns a random number from the distribution.
+	Rand() float64
+}
+
+// NewDenseRander creates a new matrix with provided number of rows and columns
+// which is initialized to random numbers drawn from the distribution r.
+// NewDenseRander fails if r is nil or non-positive matrix dimensions are requested.
+func NewDenseRander(rows, cols int, r Rander) (*mat.Dense, error) {
+	if r == nil {
+		return nil, fmt.Errorf("invalid distribution supplied: %v", r)
+	}
+	return newDenseFn(rows, cols, r.Rand)
+}
+
+// NewDenseNormal creates a new matrix with provided number of rows and columns
+// which is initialized to random numbers drawn from Normal distribution with mean mu
+// and standard deviation sigma. The random numbers are drawn from src.
+// If src is nil, a new source seeded with the current time is used.
+// NewDenseNormal fails if sigma is negative or non-positive matrix dimensions are requested.
+func NewDenseNormal(rows, cols int, mu, sigma float64, src rand.Source) (*mat.Dense, error) {
+	if sigma < 0 {
+		return nil, fmt.Errorf("invalid standard deviation: %f", sigma)
+	}
+	rnd := newRand(src)
+	return newDenseFn(rows, cols, func() float64 {
+		return rnd.NormFloat64()*sigma + mu
+	})
+}
+
+// NewDenseTruncNormal creates a new matrix with provided number of rows and columns
+// which is initialized to random numbers drawn from Normal distribution with mean mu
+// and standard deviation sigma truncated to interval [min, max]. The random numbers
+// are drawn from src. If src is nil, a new source seeded with the current time is used.
+// NewDenseTruncNormal fails if sigma is not positive, min is not smaller than max
+// or non-positive matrix dimensions are requested.
+func NewDenseTruncNormal(rows, cols int, mu, sigma, min, max float64, src rand.Source) (*mat.Dense, error) {
+	if sigma <= 0 {
+		return nil, fmt.Errorf("invalid standard deviation: %f", sigma)
+	}
+	if !(min < max) {
+		return nil, fmt.Errorf("invalid interval: [%f, %f]", min, max)
+	}
+	rnd := newRand(src)
+	a, b := (min-mu)/sigma, (max-mu)/sigma
+	return newDenseFn(rows, cols, func() float64 {
+		return mu + sigma*truncStdNormal(rnd, a, b)
+	})
+}
+
+// NewDenseBernoulli creates a new matrix with provided number of rows and columns
+// whose elements are set to 1.0 with probability p and to 0.0 with probability 1-p.
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseBernoulli fails if p is not in interval [0, 1] or non-positive matrix dimensions are requested.
+func NewDenseBernoulli(rows, cols int, p float64, src rand.Source) (*mat.Dense, error) {
+	if !(p >= 0 && p <= 1) {
+		return nil, fmt.Errorf("invalid probability: %f", p)
+	}
+	rnd := newRand(src)
+	return newDenseFn(rows, cols, func() float64 {
+		if rnd.Float64() < p {
+			return 1.0
+		}
+		return 0.0
+	})
+}
+
+// NewDensePoisson creates a new matrix with provided number of rows and columns
+// which is initialized to random numbers drawn from Poisson distribution with rate lambda.
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDensePoisson fails if lambda is negative or non-positive matrix dimensions are requested.
+func NewDensePoisson(rows, cols int, lambda float64, src rand.Source) (*mat.Dense, error) {
+	if !(lambda >= 0) || math.IsInf(lambda, 1) {
+		return nil, fmt.Errorf("invalid rate: %f", lambda)
+	}
+	rnd := newRand(src)
+	return newDenseFn(rows, cols, func() float64 {
+		return poisson(rnd, lambda)
+	})
+}
+
+// NewDenseExp creates a new matrix with provided number of rows and columns
+// which is initialized to random numbers drawn from Exponential distribution with given rate.
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseExp fails if rate is not positive or non-positive matrix dimensions are requested.
+func NewDenseExp(rows, cols int, rate float64, src rand.Source) (*mat.Dense, error) {
+	if !(rate > 0) {
+		return nil, fmt.Errorf("invalid rate: %f", rate)
+	}
+	rnd := newRand(src)
+	return newDenseFn(rows, cols, func() float64 {
+		return rnd.ExpFloat64() / rate
+	})
+}
+
+// newDenseFn creates a new matrix with rows x cols whose elements are set
+// to the values returned by fn. Matrix elements are set by rows.
+// It fails if invalid matrix dimensions are requested.
+func newDenseFn(rows, cols int, fn func() float64) (*mat.Dense, error) {
+	return withValidDims(rows, cols, func() (*mat.Dense, error) {
+		data := make([]float64, rows*cols)
+		for i := range data {
+			data[i] = fn()
+		}
+		return mat.NewDense(rows, cols, data), nil
+	})
+}
+
+// truncStdNormal returns a random number drawn from standard Normal distribution
+// truncated to interval [a, b] using inverse transform sampling.
+// Upper tail is sampled via the complementary CDF to retain precision.
+func truncStdNormal(rnd *rand.Rand, a, b float64) float64 {
+	if b < 0 {
+		return -truncStdNormal(rnd, -b, -a)
+	}
+
+	var z float64
+	if a > 0 {
+		qa, qb := math.Erfc(a/math.Sqrt2)/2, math.Erfc(b/math.Sqrt2)/2
+		u := qb + rnd.Float64()*(qa-qb)
+		z = math.Sqrt2 * math.Erfcinv(2*u)
+	} else {
+		pa, pb := math.Erfc(-a/math.Sqrt2)/2, math.Erfc(-b/math.Sqrt2)/2
+		u := pa + rnd.Float64()*(pb-pa)
+		z = -math.Sqrt2 * math.Erfcinv(2*u)
+	}
+
+	// guard against rounding errors
+	return math.Max(a, math.Min(b, z))
+}
+
+// poisson returns a random number drawn from Poisson distribution with rate lambda.
+// It uses Knuth's multiplication method for small lambda and Hörmann's
+// transformed rejection (PTRS) method otherwise.
+func poisson(rnd *rand.Rand, lambda float64) float64 {
+	if lambda < 10 {
+		l := math.Exp(-lambda)
+		k, p := 0.0, rnd.Float64()
+		for p > l {
+			k++
+			p *= rnd.Float64()
+		}
+		return k
+	}
+
+	slam := math.Sqrt(lambda)
+	loglam := math.Log(lambda)
+	b := 0.931 + 2.53*slam
+	a := -0.059 + 0.02483*b
+	invalpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+
+	for {
+		u := rnd.Float64() - 0.5
+		v := rnd.Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + lambda + 0.43)
+		if us >= 0.07 && v <= vr {
+			return k
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(invalpha)-math.Log(a/(us*us)+b) <= -lambda+k*loglam-lg {
+			return k
+		}
+	}
+}
+
+// NewDenseRandOrthogonal creates a new n x n random orthogonal matrix
+// which is uniformly distributed with respect to Haar measure.
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseRandOrthogonal fails if non-positive matrix dimensions are requested.
+func NewDenseRandOrthogonal(n int, src rand.Source) (*mat.Dense, error) {
+	return NewDenseOrthogonal(n, n, 1.0, src)
+}
+
+// NewSymDenseRandSPD creates a new n x n random symmetric positive definite matrix
+// with condition number cond. The eigenvalues of the matrix are logarithmically
+// spaced in interval [1, cond] and its eigenvectors are Haar distributed.
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewSymDenseRandSPD fails if cond is smaller than 1 or non-positive matrix dimensions are requested.
+func NewSymDenseRandSPD(n int, cond float64, src rand.Source) (*mat.SymDense, error) {
+	if !(cond >= 1) || math.IsInf(cond, 1) {
+		return nil, fmt.Errorf("invalid condition number: %f", cond)
+	}
+	q, err := NewDenseRandOrthogonal(n, src)
+	if err != nil {
+		return nil, err
+	}
+
+	// Q*diag(sqrt(eig)) so that Q*diag(eig)*Q^T is the outer product of it
+	vals := logVals(n, cond)
+	for j, v := range vals {
+		col := q.ColView(j).(*mat.VecDense)
+		col.ScaleVec(math.Sqrt(v), col)
+	}
+
+	m := &mat.SymDense{}
+	m.SymOuterK(1.0, q)
+
+	return m, nil
+}
+
+// NewSymDenseRandCorr creates a new n x n random correlation matrix i.e. a symmetric
+// positive definite matrix with unit diagonal and off-diagonal elements in interval [-1, 1].
+// The matrix is computed as a correlation matrix of 2*n random samples drawn from
+// n dimensional standard Normal distribution. The random numbers are drawn from src.
+// If src is nil, a new source seeded with the current time is used.
+// NewSymDenseRandCorr fails if non-positive matrix dimensions are requested.
+func NewSymDenseRandCorr(n int, src rand.Source) (*mat.SymDense, error) {
+	g, err := NewDenseNormal(n, 2*n, 0.0, 1.0, src)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &mat.SymDense{}
+	s.SymOuterK(1.0, g)
+
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = 1 / math.Sqrt(s.At(i, i))
+	}
+
+	m := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		m.SetSym(i, i, 1.0)
+		for j := i + 1; j < n; j++ {
+			m.SetSym(i, j, math.Max(-1, math.Min(1, s.At(i, j)*d[i]*d[j])))
+		}
+	}
+
+	return m, nil
+}
+
+// NewDenseRandLowRank creates a new rows x cols random matrix of given rank whose
+// non-zero singular values are logarithmically spaced in interval [1/cond, 1].
+// The left and right singular vectors of the matrix are Haar distributed.
+// The random numbers are drawn from src. If src is nil, a new source seeded with
+// the current time is used.
+// NewDenseRandLowRank fails if rank is not positive or exceeds min(rows, cols),
+// if cond is smaller than 1 or if non-positive matrix dimensions are requested.
+func NewDenseRandLowRank(rows, cols, rank int, cond float64, src rand.Source) (*mat.Dense, error) {
+	return withValidDims(rows, cols, func() (*mat.Dense, error) {
+		if rank <= 0 || rank > rows || rank > cols {
+			return nil, fmt.Errorf("invalid rank: %d", rank)
+		}
+		if !(cond >= 1) || math.IsInf(cond, 1) {
+			return nil, fmt.Errorf("invalid condition number: %f", cond)
+		}
+		rnd := newRand(src)
+
+		u, err := NewDenseOrthogonal(rows, rank, 1.0, rnd)
+		if err != nil {
+			return nil, err
+		}
+		v, err := NewDenseOrthogonal(cols, rank, 1.0, rnd)
+		if err != nil {
+			return nil, err
+		}
+
+		// U*diag(s)*V^T
+		vals := logVals(rank, cond)
+		for j, s := range vals {
+			col := u.ColView(j).(*mat.VecDense)
+			col.ScaleVec(s/cond, col)
+		}
+
+		m := &mat.Dense{}
+		m.Mul(u, v.T())
+
+		return m, nil
+	})
+}
+
+// logVals returns n logarithmically spaced values in interval [1, max].
+// The values are sorted in descending order.
+func logVals(n int, max float64) []float64 {
+	vals := make([]float64, n)
+	for i := range vals {
+		if n == 1 {
+			vals[i] = max
+			break
+		}
+		vals[i] = math.Pow(max, float64(n-1-i)/float64(n-1))
+	}
+	return vals
+}
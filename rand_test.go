@@ -0,0 +1,235 @@
+package matrix
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+type constRander float64
+
+func (c constRander) Rand() float64 { return float64(c) }
+
+func TestNewDenseRander(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDenseRander(2, 3, constRander(2.5))
+	assert.NoError(err)
+	exp, _ := NewDenseVal(2, 3, 2.5)
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseRander(2, 3, nil)
+	assert.Nil(m)
+	assert.Error(err)
+
+	m, err = NewDenseRander(0, 3, constRander(2.5))
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseNormal(t *testing.T) {
+	assert := assert.New(t)
+
+	mu, sigma := 2.0, 3.0
+	m, err := NewDenseNormal(100, 100, mu, sigma, rand.NewSource(1))
+	assert.NoError(err)
+	vals := Unroll(m, true).RawVector().Data
+	mean, std := stat.MeanStdDev(vals, nil)
+	assert.InDelta(mu, mean, 0.1)
+	assert.InDelta(sigma, std, 0.1)
+
+	m, err = NewDenseNormal(2, 2, mu, -1.0, nil)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseTruncNormal(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		mu, sigma, min, max float64
+	}{
+		{0.0, 1.0, -1.0, 1.0},
+		{0.0, 1.0, 5.0, 6.0},
+		{0.0, 1.0, -40.0, -30.0},
+		{1.0, 2.0, 0.0, math.Inf(1)},
+		{1.0, 2.0, math.Inf(-1), 1.0},
+	}
+
+	for _, tc := range tests {
+		m, err := NewDenseTruncNormal(50, 50, tc.mu, tc.sigma, tc.min, tc.max, rand.NewSource(1))
+		assert.NoError(err)
+		assert.True(mat.Min(m) >= tc.min)
+		assert.True(mat.Max(m) <= tc.max)
+		assert.False(math.IsNaN(mat.Sum(m)))
+	}
+
+	// symmetric truncation keeps the mean
+	m, err := NewDenseTruncNormal(100, 100, 1.0, 1.0, -1.0, 3.0, rand.NewSource(1))
+	assert.NoError(err)
+	assert.InDelta(1.0, mean(m), 0.05)
+
+	m, err = NewDenseTruncNormal(2, 2, 0.0, 0.0, -1.0, 1.0, nil)
+	assert.Nil(m)
+	assert.Error(err)
+
+	m, err = NewDenseTruncNormal(2, 2, 0.0, 1.0, 1.0, 1.0, nil)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseBernoulli(t *testing.T) {
+	assert := assert.New(t)
+
+	p := 0.3
+	m, err := NewDenseBernoulli(100, 100, p, rand.NewSource(1))
+	assert.NoError(err)
+	r, c := m.Dims()
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := m.At(i, j)
+			assert.True(v == 0.0 || v == 1.0)
+		}
+	}
+	assert.InDelta(p, mean(m), 0.02)
+
+	for _, p := range []float64{-0.1, 1.1, math.NaN()} {
+		m, err = NewDenseBernoulli(2, 2, p, nil)
+		assert.Nil(m)
+		assert.Error(err)
+	}
+}
+
+func TestNewDensePoisson(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, lambda := range []float64{0.0, 3.0, 50.0} {
+		m, err := NewDensePoisson(100, 100, lambda, rand.NewSource(1))
+		assert.NoError(err)
+		vals := Unroll(m, true).RawVector().Data
+		mean, variance := stat.MeanVariance(vals, nil)
+		assert.InDelta(lambda, mean, 0.05*lambda+0.01)
+		assert.InDelta(lambda, variance, 0.1*lambda+0.01)
+		for _, v := range vals {
+			assert.Equal(math.Floor(v), v)
+		}
+	}
+
+	m, err := NewDensePoisson(2, 2, -1.0, nil)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseExp(t *testing.T) {
+	assert := assert.New(t)
+
+	rate := 2.0
+	m, err := NewDenseExp(100, 100, rate, rand.NewSource(1))
+	assert.NoError(err)
+	assert.True(mat.Min(m) >= 0)
+	assert.InDelta(1/rate, mean(m), 0.02)
+
+	m, err = NewDenseExp(2, 2, 0.0, nil)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseRandOrthogonal(t *testing.T) {
+	assert := assert.New(t)
+
+	n := 4
+	q, err := NewDenseRandOrthogonal(n, rand.NewSource(1))
+	assert.NoError(err)
+
+	p := &mat.Dense{}
+	p.Mul(q.T(), q)
+	eye, _ := NewDenseValIdentity(n, 1.0)
+	assert.True(mat.EqualApprox(eye, p, 1e-10))
+
+	q, err = NewDenseRandOrthogonal(0, nil)
+	assert.Nil(q)
+	assert.Error(err)
+}
+
+func TestNewSymDenseRandSPD(t *testing.T) {
+	assert := assert.New(t)
+
+	n, cond := 5, 100.0
+	m, err := NewSymDenseRandSPD(n, cond, rand.NewSource(1))
+	assert.NoError(err)
+	assert.Equal(n, m.SymmetricDim())
+
+	var chol mat.Cholesky
+	assert.True(chol.Factorize(m))
+
+	var eig mat.EigenSym
+	assert.True(eig.Factorize(m, false))
+	vals := eig.Values(nil)
+	assert.InDelta(1.0, vals[0], 1e-8)
+	assert.InDelta(cond, vals[n-1], 1e-8)
+
+	m, err = NewSymDenseRandSPD(n, 0.5, nil)
+	assert.Nil(m)
+	assert.Error(err)
+
+	m, err = NewSymDenseRandSPD(-1, cond, nil)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewSymDenseRandCorr(t *testing.T) {
+	assert := assert.New(t)
+
+	n := 5
+	m, err := NewSymDenseRandCorr(n, rand.NewSource(1))
+	assert.NoError(err)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(1.0, m.At(i, i))
+		for j := 0; j < n; j++ {
+			assert.True(math.Abs(m.At(i, j)) <= 1.0)
+		}
+	}
+
+	var chol mat.Cholesky
+	assert.True(chol.Factorize(m))
+
+	m, err = NewSymDenseRandCorr(0, nil)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseRandLowRank(t *testing.T) {
+	assert := assert.New(t)
+
+	rows, cols, rank, cond := 6, 4, 2, 10.0
+	m, err := NewDenseRandLowRank(rows, cols, rank, cond, rand.NewSource(1))
+	assert.NoError(err)
+	r, c := m.Dims()
+	assert.Equal(rows, r)
+	assert.Equal(cols, c)
+
+	var svd mat.SVD
+	assert.True(svd.Factorize(m, mat.SVDNone))
+	vals := svd.Values(nil)
+	assert.InDeltaSlice([]float64{1.0, 1.0 / cond, 0.0, 0.0}, vals, 1e-10)
+
+	tests := []struct {
+		rank int
+		cond float64
+	}{
+		{0, cond},
+		{cols + 1, cond},
+		{rank, 0.9},
+	}
+
+	for _, tc := range tests {
+		m, err = NewDenseRandLowRank(rows, cols, tc.rank, tc.cond, nil)
+		assert.Nil(m)
+		assert.Error(err)
+	}
+}
@@ -0,0 +1,87 @@
+package matrix
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ReduceFunc reduces a matrix row or column to a single value.
+// The row or column is passed in as a vector which is only valid
+// for the duration of the call so it must not be retained or modified.
+type ReduceFunc func(mat.Matrix) float64
+
+// Reduce applies fn to matrix rows or columns as selected by dim and returns the results in a slice.
+// If idx is nil fn is applied to all rows or columns, otherwise it is applied only to the rows or
+// columns with indices stored in idx and the i-th result corresponds to the i-th index in idx.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid, fn is nil
+// or any of the indices is out of range.
+func Reduce(dim Dim, m mat.Matrix, fn ReduceFunc, idx []int) ([]float64, error) {
+	n, err := dimLen(dim, m)
+	if err != nil {
+		return nil, err
+	}
+	if idx != nil {
+		n = len(idx)
+	}
+	dst := make([]float64, n)
+	if err := ReduceInto(dst, dim, m, fn, idx); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// ReduceInto applies fn to matrix rows or columns as selected by dim and stores the results in dst.
+// If idx is nil fn is applied to all rows or columns, otherwise it is applied only to the rows or
+// columns with indices stored in idx and dst[i] holds the result for idx[i].
+// It returns error if passed in matrix is nil, has zero size, dim is invalid, fn is nil, any of
+// the indices is out of range or dst length does not match the number of reduced rows or columns.
+func ReduceInto(dst []float64, dim Dim, m mat.Matrix, fn ReduceFunc, idx []int) error {
+	n, err := dimLen(dim, m)
+	if err != nil {
+		return err
+	}
+	if fn == nil {
+		return fmt.Errorf("invalid reduce function supplied: %v", fn)
+	}
+
+	count := n
+	if idx != nil {
+		count = len(idx)
+		for _, i := range idx {
+			if i < 0 || i >= n {
+				return fmt.Errorf("%w: %d", ErrIndexOutOfRange, i)
+			}
+		}
+	}
+	if len(dst) != count {
+		return fmt.Errorf("%w: dst length: %d, expected: %d", ErrDimMismatch, len(dst), count)
+	}
+
+	view := dimView(dim, m)
+	for k := range dst {
+		i := k
+		if idx != nil {
+			i = idx[k]
+		}
+		dst[k] = fn(view(i))
+	}
+
+	return nil
+}
+
+// dimLen returns the number of rows or columns of matrix m as selected by dim.
+// It returns error if m is nil, has zero size or dim is invalid.
+func dimLen(dim Dim, m mat.Matrix) (int, error) {
+	if err := validMatrix(m); err != nil {
+		return 0, err
+	}
+	if err := dim.validate(); err != nil {
+		return 0, err
+	}
+	rows, cols := m.Dims()
+	if dim == Cols {
+		return cols, nil
+	}
+	return rows, nil
+}
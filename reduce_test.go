@@ -0,0 +1,79 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestReduce(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1.2, 3.4, 5.6,
+		4.5, 6.7, 7.1,
+		8.9, 10.0, 2.3,
+	}
+	mx := mat.NewDense(3, 3, data)
+
+	// range of values
+	ptp := func(v mat.Matrix) float64 {
+		return mat.Max(v) - mat.Min(v)
+	}
+
+	res, err := Reduce(Rows, mx, ptp, nil)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{4.4, 2.6, 7.7}, res, 1e-12)
+
+	res, err = Reduce(Cols, mx, ptp, []int{2, 0})
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{4.8, 7.7}, res, 1e-12)
+
+	// existing reductions are special cases of Reduce
+	res, err = Reduce(Cols, mx, mat.Sum, nil)
+	assert.NoError(err)
+	exp, _ := ColsSum(3, mx)
+	assert.Equal(exp, res)
+
+	// empty index slice selects nothing
+	res, err = Reduce(Rows, mx, mat.Sum, []int{})
+	assert.NoError(err)
+	assert.Empty(res)
+
+	dst := make([]float64, 2)
+	err = ReduceInto(dst, Rows, mx.T(), mat.Max, []int{1, 1})
+	assert.NoError(err)
+	assert.Equal([]float64{10.0, 10.0}, dst)
+
+	// dst length mismatch
+	err = ReduceInto(dst, Rows, mx, mat.Max, nil)
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	// index out of range
+	res, err = Reduce(Cols, mx, mat.Max, []int{0, 3})
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrIndexOutOfRange))
+
+	res, err = Reduce(Cols, mx, mat.Max, []int{-1})
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrIndexOutOfRange))
+
+	// invalid arguments
+	res, err = Reduce(Cols, mx, nil, nil)
+	assert.Nil(res)
+	assert.Error(err)
+
+	res, err = Reduce(Dim(0), mx, mat.Max, nil)
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrInvalidDim))
+
+	res, err = Reduce(Rows, nil, mat.Max, nil)
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	res, err = Reduce(Rows, &mat.Dense{}, mat.Max, nil)
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrZeroSize))
+}
@@ -0,0 +1,369 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+// Scaler scales matrix columns using parameters fitted to a matrix of observations
+// stored in rows. Fitted parameters are kept so the same scaling can be applied to other data.
+type Scaler interface {
+	// Fit fits scaler parameters to matrix m.
+	Fit(m mat.Matrix) error
+	// Transform returns a new matrix with columns of m scaled by fitted parameters.
+	Transform(m mat.Matrix) (*mat.Dense, error)
+	// FitTransform fits scaler parameters to matrix m and returns m scaled by them.
+	FitTransform(m mat.Matrix) (*mat.Dense, error)
+	// InverseTransform returns a new matrix with columns of m scaled back to the original scale.
+	InverseTransform(m mat.Matrix) (*mat.Dense, error)
+}
+
+// StandardScaler scales matrix columns to zero mean and unit standard deviation (z-score).
+type StandardScaler struct {
+	// Mean is the mean of fitted columns
+	Mean []float64
+	// Stdev is the sample standard deviation of fitted columns
+	Stdev []float64
+}
+
+// Fit computes the mean and standard deviation of columns of matrix m.
+// The sample standard deviation of a single row is undefined, so if m has only one row
+// the standard deviation is set to zero and Transform only centres the columns.
+// It returns error if passed in matrix is nil or has zero size.
+func (s *StandardScaler) Fit(m mat.Matrix) error {
+	if err := validMatrix(m); err != nil {
+		return err
+	}
+	rows, cols := m.Dims()
+	mean, _ := ColsMean(cols, m)
+	stdev := make([]float64, cols)
+	if rows > 1 {
+		stdev, _ = ColsStdev(cols, m)
+	}
+	s.Mean, s.Stdev = mean, stdev
+	return nil
+}
+
+// Transform returns a new matrix with the fitted mean subtracted from columns of matrix m
+// and the result divided by the fitted standard deviation. Columns with zero standard
+// deviation are only centred.
+// It returns error if the scaler is not fitted, m is nil, has zero size
+// or its number of columns does not match the fitted data.
+func (s *StandardScaler) Transform(m mat.Matrix) (*mat.Dense, error) {
+	return transform(s, m)
+}
+
+// FitTransform fits the scaler to matrix m and returns m transformed by it.
+// It returns error if passed in matrix is nil or has zero size.
+func (s *StandardScaler) FitTransform(m mat.Matrix) (*mat.Dense, error) {
+	return fitTransform(s, m)
+}
+
+// InverseTransform returns a new matrix with columns of matrix m multiplied by the fitted
+// standard deviation and the fitted mean added to them.
+// It returns error if the scaler is not fitted, m is nil, has zero size
+// or its number of columns does not match the fitted data.
+func (s *StandardScaler) InverseTransform(m mat.Matrix) (*mat.Dense, error) {
+	return inverseTransform(s, m)
+}
+
+func (s *StandardScaler) params() (shift, scale []float64, err error) {
+	if err := validFitted(s.Mean, s.Stdev); err != nil {
+		return nil, nil, err
+	}
+	return s.Mean, nonZero(s.Stdev), nil
+}
+
+// MinMaxScaler scales matrix columns to interval [Min, Max].
+type MinMaxScaler struct {
+	// Min is the lower bound of the scaled values
+	Min float64
+	// Max is the upper bound of the scaled values
+	Max float64
+	// DataMin is the min value of fitted columns
+	DataMin []float64
+	// DataMax is the max value of fitted columns
+	DataMax []float64
+}
+
+// NewMinMaxScaler creates a new MinMaxScaler which scales matrix columns to interval [min, max].
+// It returns error if min is not smaller than max.
+func NewMinMaxScaler(min, max float64) (*MinMaxScaler, error) {
+	if err := validScaleRange(min, max); err != nil {
+		return nil, err
+	}
+	return &MinMaxScaler{Min: min, Max: max}, nil
+}
+
+// Fit computes the min and max values of columns of matrix m.
+// It returns error if passed in matrix is nil, has zero size or the scaler range is invalid.
+func (s *MinMaxScaler) Fit(m mat.Matrix) error {
+	if err := validScaleRange(s.Min, s.Max); err != nil {
+		return err
+	}
+	if err := validMatrix(m); err != nil {
+		return err
+	}
+	_, cols := m.Dims()
+	min, _ := ColsMin(cols, m)
+	max, _ := ColsMax(cols, m)
+	s.DataMin, s.DataMax = min, max
+	return nil
+}
+
+// Transform returns a new matrix with columns of matrix m linearly mapped from the fitted
+// [DataMin, DataMax] interval to [Min, Max]. Constant columns are mapped to Min.
+// Values outside of the fitted interval are mapped outside of [Min, Max].
+// It returns error if the scaler is not fitted, m is nil, has zero size
+// or its number of columns does not match the fitted data.
+func (s *MinMaxScaler) Transform(m mat.Matrix) (*mat.Dense, error) {
+	return transform(s, m)
+}
+
+// FitTransform fits the scaler to matrix m and returns m transformed by it.
+// It returns error if passed in matrix is nil, has zero size or the scaler range is invalid.
+func (s *MinMaxScaler) FitTransform(m mat.Matrix) (*mat.Dense, error) {
+	return fitTransform(s, m)
+}
+
+// InverseTransform returns a new matrix with columns of matrix m linearly mapped
+// from [Min, Max] interval back to the fitted [DataMin, DataMax] interval.
+// It returns error if the scaler is not fitted, m is nil, has zero size
+// or its number of columns does not match the fitted data.
+func (s *MinMaxScaler) InverseTransform(m mat.Matrix) (*mat.Dense, error) {
+	return inverseTransform(s, m)
+}
+
+func (s *MinMaxScaler) params() (shift, scale []float64, err error) {
+	if err := validFitted(s.DataMin, s.DataMax); err != nil {
+		return nil, nil, err
+	}
+	if err := validScaleRange(s.Min, s.Max); err != nil {
+		return nil, nil, err
+	}
+	shift = make([]float64, len(s.DataMin))
+	scale = make([]float64, len(s.DataMin))
+	for j := range scale {
+		scale[j] = (s.DataMax[j] - s.DataMin[j]) / (s.Max - s.Min)
+		if scale[j] == 0 {
+			scale[j] = 1
+		}
+		shift[j] = s.DataMin[j] - s.Min*scale[j]
+	}
+	return shift, scale, nil
+}
+
+// RobustScaler scales matrix columns by subtracting their median and dividing them
+// by their interquartile range, which makes it insensitive to outliers.
+type RobustScaler struct {
+	// Median is the median of fitted columns
+	Median []float64
+	// IQR is the interquartile range of fitted columns
+	IQR []float64
+	// Kind is the cumulant kind used to compute the quartiles.
+	// Zero value means stat.LinInterp.
+	Kind stat.CumulantKind
+}
+
+// Fit computes the median and interquartile range of columns of matrix m.
+// The interquartile range is computed by ColsIQR using the scaler cumulant kind.
+// It returns error if passed in matrix is nil, has zero size or the cumulant kind is invalid.
+func (s *RobustScaler) Fit(m mat.Matrix) error {
+	n, err := dimLen(Cols, m)
+	if err != nil {
+		return err
+	}
+	kind := s.Kind
+	if kind == 0 {
+		kind = stat.LinInterp
+	}
+	iqr, err := ColsIQR(n, m, kind)
+	if err != nil {
+		return err
+	}
+	median, _ := ColsMedian(n, m)
+	s.Median, s.IQR = median, iqr
+	return nil
+}
+
+// Transform returns a new matrix with the fitted median subtracted from columns of matrix m
+// and the result divided by the fitted interquartile range. Columns with zero interquartile
+// range are only centred.
+// It returns error if the scaler is not fitted, m is nil, has zero size
+// or its number of columns does not match the fitted data.
+func (s *RobustScaler) Transform(m mat.Matrix) (*mat.Dense, error) {
+	return transform(s, m)
+}
+
+// FitTransform fits the scaler to matrix m and returns m transformed by it.
+// It returns error if passed in matrix is nil or has zero size.
+func (s *RobustScaler) FitTransform(m mat.Matrix) (*mat.Dense, error) {
+	return fitTransform(s, m)
+}
+
+// InverseTransform returns a new matrix with columns of matrix m multiplied by the fitted
+// interquartile range and the fitted median added to them.
+// It returns error if the scaler is not fitted, m is nil, has zero size
+// or its number of columns does not match the fitted data.
+func (s *RobustScaler) InverseTransform(m mat.Matrix) (*mat.Dense, error) {
+	return inverseTransform(s, m)
+}
+
+func (s *RobustScaler) params() (shift, scale []float64, err error) {
+	if err := validFitted(s.Median, s.IQR); err != nil {
+		return nil, nil, err
+	}
+	return s.Median, nonZero(s.IQR), nil
+}
+
+// MaxAbsScaler scales matrix columns by their max absolute value to interval [-1, 1].
+// It does not shift the data so it preserves sparsity.
+type MaxAbsScaler struct {
+	// MaxAbs is the max absolute value of fitted columns
+	MaxAbs []float64
+}
+
+// Fit computes the max absolute value of columns of matrix m.
+// It returns error if passed in matrix is nil or has zero size.
+func (s *MaxAbsScaler) Fit(m mat.Matrix) error {
+	if err := validMatrix(m); err != nil {
+		return err
+	}
+	_, cols := m.Dims()
+	min, _ := ColsMin(cols, m)
+	max, _ := ColsMax(cols, m)
+	for j := range max {
+		max[j] = math.Max(math.Abs(min[j]), math.Abs(max[j]))
+	}
+	s.MaxAbs = max
+	return nil
+}
+
+// Transform returns a new matrix with columns of matrix m divided by the fitted max absolute value.
+// Columns whose max absolute value is zero are left unchanged.
+// It returns error if the scaler is not fitted, m is nil, has zero size
+// or its number of columns does not match the fitted data.
+func (s *MaxAbsScaler) Transform(m mat.Matrix) (*mat.Dense, error) {
+	return transform(s, m)
+}
+
+// FitTransform fits the scaler to matrix m and returns m transformed by it.
+// It returns error if passed in matrix is nil or has zero size.
+func (s *MaxAbsScaler) FitTransform(m mat.Matrix) (*mat.Dense, error) {
+	return fitTransform(s, m)
+}
+
+// InverseTransform returns a new matrix with columns of matrix m multiplied by the fitted max absolute value.
+// It returns error if the scaler is not fitted, m is nil, has zero size
+// or its number of columns does not match the fitted data.
+func (s *MaxAbsScaler) InverseTransform(m mat.Matrix) (*mat.Dense, error) {
+	return inverseTransform(s, m)
+}
+
+func (s *MaxAbsScaler) params() (shift, scale []float64, err error) {
+	if err := validFitted(s.MaxAbs, s.MaxAbs); err != nil {
+		return nil, nil, err
+	}
+	return make([]float64, len(s.MaxAbs)), nonZero(s.MaxAbs), nil
+}
+
+// affine is a scaler which transforms columns as (x - shift) / scale.
+// It returns error if it has not been fitted or its fitted parameters are invalid.
+type affine interface {
+	params() (shift, scale []float64, err error)
+}
+
+// fitTransform fits s to m and returns m transformed by it
+func fitTransform(s Scaler, m mat.Matrix) (*mat.Dense, error) {
+	if err := s.Fit(m); err != nil {
+		return nil, err
+	}
+	return s.Transform(m)
+}
+
+// transform returns a new matrix with columns of m transformed as (x - shift) / scale
+func transform(s affine, m mat.Matrix) (*mat.Dense, error) {
+	shift, scale, err := validParams(s, m)
+	if err != nil {
+		return nil, err
+	}
+	dst := &mat.Dense{}
+	if err := SubInto(dst, m, shift); err != nil {
+		return nil, err
+	}
+	if err := DivInto(dst, dst, scale); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// inverseTransform returns a new matrix with columns of m transformed as x * scale + shift
+func inverseTransform(s affine, m mat.Matrix) (*mat.Dense, error) {
+	shift, scale, err := validParams(s, m)
+	if err != nil {
+		return nil, err
+	}
+	dst := &mat.Dense{}
+	if err := MulInto(dst, m, scale); err != nil {
+		return nil, err
+	}
+	if err := AddInto(dst, dst, shift); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// validParams returns fitted parameters of s as row vectors.
+// It returns error if s has not been fitted, its parameters are invalid, m is nil,
+// has zero size or its number of columns does not match the number of fitted parameters.
+func validParams(s affine, m mat.Matrix) (shift, scale *mat.Dense, err error) {
+	sh, sc, err := s.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sh) != len(sc) {
+		return nil, nil, fmt.Errorf("%w: parameters length mismatch: %d, %d", ErrNotFitted, len(sh), len(sc))
+	}
+	if err := validMatrix(m); err != nil {
+		return nil, nil, err
+	}
+	if rows, cols := m.Dims(); cols != len(sh) {
+		return nil, nil, &DimError{Expected: Shape{rows, len(sh)}, Actual: Shape{rows, cols}}
+	}
+	return mat.NewDense(1, len(sh), sh), mat.NewDense(1, len(sc), sc), nil
+}
+
+// validFitted returns error if fitted parameters x and y are empty or their lengths differ.
+// Fitted parameters are exported so they may have been set by the caller.
+func validFitted(x, y []float64) error {
+	if len(x) == 0 {
+		return ErrNotFitted
+	}
+	if len(x) != len(y) {
+		return fmt.Errorf("%w: parameters length mismatch: %d, %d", ErrNotFitted, len(x), len(y))
+	}
+	return nil
+}
+
+// validScaleRange returns error if min is not smaller than max
+func validScaleRange(min, max float64) error {
+	if !(min < max) {
+		return fmt.Errorf("invalid scale range: [%f, %f]", min, max)
+	}
+	return nil
+}
+
+// nonZero returns a copy of x with zero values replaced by ones
+func nonZero(x []float64) []float64 {
+	res := make([]float64, len(x))
+	for i, v := range x {
+		res[i] = v
+		if v == 0 {
+			res[i] = 1
+		}
+	}
+	return res
+}
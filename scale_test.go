@@ -0,0 +1,213 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func TestScalers(t *testing.T) {
+	assert := assert.New(t)
+
+	mx := mat.NewDense(4, 3, []float64{
+		1.0, -4.0, 5.0,
+		2.0, 2.0, 5.0,
+		3.0, 0.0, 5.0,
+		10.0, 2.0, 5.0,
+	})
+
+	minMax, err := NewMinMaxScaler(-1.0, 1.0)
+	assert.NoError(err)
+
+	// means: 4.0, 0.0, 5.0 and sample standard deviations: sqrt(50/3), sqrt(8), 0.0
+	sd0, sd1 := math.Sqrt(50.0/3), math.Sqrt(8.0)
+
+	tests := []struct {
+		s   Scaler
+		exp []float64
+	}{
+		{&StandardScaler{}, []float64{
+			-3.0 / sd0, -4.0 / sd1, 0.0,
+			-2.0 / sd0, 2.0 / sd1, 0.0,
+			-1.0 / sd0, 0.0, 0.0,
+			6.0 / sd0, 2.0 / sd1, 0.0,
+		}},
+		{minMax, []float64{
+			-1.0, -1.0, -1.0,
+			-7.0 / 9, 1.0, -1.0,
+			-5.0 / 9, 1.0 / 3, -1.0,
+			1.0, 1.0, -1.0,
+		}},
+		// medians: 2.5, 1.0, 5.0 and IQRs: 2.0, 6.0, 0.0
+		{&RobustScaler{}, []float64{
+			-0.75, -5.0 / 6, 0.0,
+			-0.25, 1.0 / 6, 0.0,
+			0.25, -1.0 / 6, 0.0,
+			3.75, 1.0 / 6, 0.0,
+		}},
+		{&MaxAbsScaler{}, []float64{
+			0.1, -1.0, 1.0,
+			0.2, 0.5, 1.0,
+			0.3, 0.0, 1.0,
+			1.0, 0.5, 1.0,
+		}},
+	}
+
+	for _, tc := range tests {
+		res, err := tc.s.Transform(mx)
+		assert.Nil(res)
+		assert.True(errors.Is(err, ErrNotFitted))
+
+		res, err = tc.s.FitTransform(mx)
+		assert.NoError(err)
+		assert.True(mat.EqualApprox(mat.NewDense(4, 3, tc.exp), res, 1e-12))
+
+		inv, err := tc.s.InverseTransform(res)
+		assert.NoError(err)
+		assert.True(mat.EqualApprox(mx, inv, 1e-12))
+
+		// input is not modified
+		assert.Equal(10.0, mx.At(3, 0))
+
+		res, err = tc.s.Transform(mat.NewDense(4, 2, nil))
+		assert.Nil(res)
+		assert.Equal(&DimError{Expected: Shape{4, 3}, Actual: Shape{4, 2}}, err)
+
+		err = tc.s.Fit(nil)
+		assert.True(errors.Is(err, ErrNilMatrix))
+
+		res, err = tc.s.FitTransform(&mat.Dense{})
+		assert.Nil(res)
+		assert.True(errors.Is(err, ErrZeroSize))
+	}
+}
+
+func TestScalersInvalidParams(t *testing.T) {
+	assert := assert.New(t)
+
+	mx := mat.NewDense(2, 2, []float64{1.0, 2.0, 3.0, 4.0})
+
+	tests := []Scaler{
+		&StandardScaler{Mean: []float64{1.0, 2.0}},
+		&StandardScaler{Mean: []float64{}, Stdev: []float64{}},
+		&MinMaxScaler{Min: 0.0, Max: 1.0, DataMin: []float64{1.0, 2.0}, DataMax: []float64{3.0}},
+		&RobustScaler{Median: []float64{1.0, 2.0}, IQR: []float64{1.0}},
+		&MaxAbsScaler{MaxAbs: []float64{}},
+	}
+
+	for _, s := range tests {
+		res, err := s.Transform(mx)
+		assert.Nil(res)
+		assert.True(errors.Is(err, ErrNotFitted))
+
+		res, err = s.InverseTransform(mx)
+		assert.Nil(res)
+		assert.True(errors.Is(err, ErrNotFitted))
+	}
+
+	// range changed after fitting
+	s := &MinMaxScaler{Min: 1.0, Max: 1.0, DataMin: []float64{1.0, 2.0}, DataMax: []float64{3.0, 4.0}}
+	res, err := s.Transform(mx)
+	assert.Nil(res)
+	assert.Error(err)
+}
+
+func TestStandardScaler(t *testing.T) {
+	assert := assert.New(t)
+
+	train, err := NewDenseNormal(50, 3, 5.0, 2.0, rand.NewSource(1))
+	assert.NoError(err)
+	test, err := NewDenseNormal(10, 3, 5.0, 2.0, rand.NewSource(2))
+	assert.NoError(err)
+
+	s := &StandardScaler{}
+	res, err := s.FitTransform(train)
+	assert.NoError(err)
+
+	mean, _ := ColsMean(3, res)
+	assert.InDeltaSlice([]float64{0.0, 0.0, 0.0}, mean, 1e-12)
+	sd, _ := ColsStdev(3, res)
+	assert.InDeltaSlice([]float64{1.0, 1.0, 1.0}, sd, 1e-12)
+
+	// test data is scaled by parameters fitted to the training data
+	res, err = s.Transform(test)
+	assert.NoError(err)
+	exp, _ := Sub(test, mat.NewDense(1, 3, s.Mean))
+	_ = DivInto(exp, exp, mat.NewDense(1, 3, s.Stdev))
+	assert.True(mat.EqualApprox(exp, res, 1e-12))
+
+	// single row is only centred
+	res, err = s.FitTransform(mat.NewDense(1, 2, []float64{3.0, -1.0}))
+	assert.NoError(err)
+	assert.Equal([]float64{0.0, 0.0}, s.Stdev)
+	assert.Equal([]float64{0.0, 0.0}, res.RawMatrix().Data)
+	res, err = s.InverseTransform(res)
+	assert.NoError(err)
+	assert.Equal([]float64{3.0, -1.0}, res.RawMatrix().Data)
+
+	// constant columns are only centred
+	res, err = s.FitTransform(mat.NewDense(2, 1, []float64{3.0, 3.0}))
+	assert.NoError(err)
+	assert.Equal([]float64{0.0, 0.0}, res.RawMatrix().Data)
+}
+
+func TestMinMaxScaler(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, r := range [][2]float64{{1.0, 1.0}, {1.0, 0.0}} {
+		s, err := NewMinMaxScaler(r[0], r[1])
+		assert.Nil(s)
+		assert.Error(err)
+	}
+
+	s := &MinMaxScaler{}
+	assert.Error(s.Fit(mat.NewDense(2, 2, nil)))
+
+	s = &MinMaxScaler{Min: 0.0, Max: 1.0}
+	res, err := s.FitTransform(mat.NewDense(3, 1, []float64{2.0, 4.0, 6.0}))
+	assert.NoError(err)
+	assert.Equal([]float64{0.0, 0.5, 1.0}, res.RawMatrix().Data)
+	assert.Equal([]float64{2.0}, s.DataMin)
+	assert.Equal([]float64{6.0}, s.DataMax)
+
+	// values outside of fitted range are mapped outside of scaler range
+	res, err = s.Transform(mat.NewDense(1, 1, []float64{8.0}))
+	assert.NoError(err)
+	assert.Equal(1.5, res.At(0, 0))
+}
+
+func TestRobustScaler(t *testing.T) {
+	assert := assert.New(t)
+
+	mx := mat.NewDense(5, 2, []float64{
+		1.0, 3.0,
+		2.0, -1.0,
+		3.0, 0.0,
+		4.0, 8.0,
+		10.0, 2.0,
+	})
+
+	for _, c := range []stat.CumulantKind{stat.LinInterp, stat.Empirical} {
+		s := &RobustScaler{Kind: c}
+		assert.NoError(s.Fit(mx))
+		iqr, _ := ColsIQR(2, mx, c)
+		assert.Equal(iqr, s.IQR)
+		median, _ := ColsMedian(2, mx)
+		assert.Equal(median, s.Median)
+	}
+
+	// zero value kind is stat.LinInterp
+	s := &RobustScaler{}
+	assert.NoError(s.Fit(mx))
+	iqr, _ := ColsIQR(2, mx, stat.LinInterp)
+	assert.Equal(iqr, s.IQR)
+
+	s = &RobustScaler{Kind: stat.CumulantKind(100)}
+	assert.Error(s.Fit(mx))
+	assert.Nil(s.IQR)
+}
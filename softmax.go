@@ -0,0 +1,72 @@
+package matrix
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Softmax returns a matrix of softmax values of matrix rows or columns as selected by dim.
+// Every row (dim Rows) or column (dim Cols) of the returned matrix is non-negative and sums to one.
+// The maximum of each row or column is subtracted before exponentiation so large values do not overflow.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func Softmax(dim Dim, m mat.Matrix) (*mat.Dense, error) {
+	return withLogSumExp(dim, m, func(x, lse float64) float64 {
+		return math.Exp(x - lse)
+	})
+}
+
+// LogSoftmax returns a matrix of logarithms of softmax values of matrix rows or columns as selected by dim.
+// It is computed as x - LogSumExp(x) which is more accurate than taking logarithm of Softmax.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func LogSoftmax(dim Dim, m mat.Matrix) (*mat.Dense, error) {
+	return withLogSumExp(dim, m, func(x, lse float64) float64 {
+		return x - lse
+	})
+}
+
+// LogSumExp returns a slice of log(sum(exp(x))) values of matrix rows or columns as selected by dim.
+// The maximum of each row or column is subtracted before exponentiation so large values do not overflow.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func LogSumExp(dim Dim, m mat.Matrix) ([]float64, error) {
+	return Reduce(dim, m, logSumExp, nil)
+}
+
+// withLogSumExp returns a copy of m whose elements x are set to fn(x, lse)
+// where lse is LogSumExp of the row or column as selected by dim.
+func withLogSumExp(dim Dim, m mat.Matrix, fn func(x, lse float64) float64) (*mat.Dense, error) {
+	n, err := dimLen(dim, m)
+	if err != nil {
+		return nil, err
+	}
+
+	d := mat.DenseCopyOf(m)
+	view := dimView(dim, d)
+	for i := 0; i < n; i++ {
+		v := view(i).(*mat.VecDense)
+		lse := logSumExp(v)
+		for k := 0; k < v.Len(); k++ {
+			v.SetVec(k, fn(v.AtVec(k), lse))
+		}
+	}
+
+	return d, nil
+}
+
+// logSumExp returns log(sum(exp(x))) of the elements x of vector v
+func logSumExp(v mat.Matrix) float64 {
+	x := values(v)
+	max := math.Inf(-1)
+	for _, val := range x {
+		max = math.Max(max, val)
+	}
+	// all elements are -Inf or any of them is +Inf
+	if math.IsInf(max, 0) {
+		return max
+	}
+	var sum float64
+	for _, val := range x {
+		sum += math.Exp(val - max)
+	}
+	return max + math.Log(sum)
+}
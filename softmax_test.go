@@ -0,0 +1,84 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestLogSumExp(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1.0, 2.0, 3.0,
+		1000.0, 1000.0, -1000.0,
+	}
+	mx := mat.NewDense(2, 3, data)
+
+	lse, err := LogSumExp(Rows, mx)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{floats.LogSumExp([]float64{1.0, 2.0, 3.0}), 1000.0 + math.Ln2}, lse, 1e-12)
+
+	lse, err = LogSumExp(Cols, mx)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{1000.0, 1000.0, 3.0}, lse, 1e-12)
+
+	lse, err = LogSumExp(Rows, mat.NewDense(1, 2, []float64{math.Inf(-1), math.Inf(-1)}))
+	assert.NoError(err)
+	assert.True(math.IsInf(lse[0], -1))
+
+	lse, err = LogSumExp(Rows, nil)
+	assert.Nil(lse)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
+
+func TestSoftmax(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1.0, 2.0, 3.0,
+		1000.0, 1000.0, -1000.0,
+	}
+	mx := mat.NewDense(2, 3, data)
+
+	sm, err := Softmax(Rows, mx)
+	assert.NoError(err)
+	e := []float64{math.Exp(1.0), math.Exp(2.0), math.Exp(3.0)}
+	floats.Scale(1/floats.Sum(e), e)
+	exp := mat.NewDense(2, 3, append(e, 0.5, 0.5, 0.0))
+	assert.True(mat.EqualApprox(exp, sm, 1e-12))
+	// input is not modified
+	assert.Equal(1.0, mx.At(0, 0))
+
+	sm, err = Softmax(Cols, mx)
+	assert.NoError(err)
+	sums, _ := ColsSum(3, sm)
+	assert.InDeltaSlice([]float64{1.0, 1.0, 1.0}, sums, 1e-12)
+	assert.InDelta(1.0, sm.At(1, 0), 1e-12)
+
+	// softmax of transposed matrix along columns is transposed softmax along rows
+	st, err := Softmax(Cols, mx.T())
+	assert.NoError(err)
+	exp = mat.DenseCopyOf(st.T())
+	sm, _ = Softmax(Rows, mx)
+	assert.True(mat.EqualApprox(exp, sm, 1e-12))
+
+	lsm, err := LogSoftmax(Rows, mx)
+	assert.NoError(err)
+	_, err = Log(sm)
+	assert.NoError(err)
+	assert.InDeltaSlice(sm.RawRowView(0), lsm.RawRowView(0), 1e-12)
+	assert.InDeltaSlice([]float64{-math.Ln2, -math.Ln2, -2000.0 - math.Ln2}, lsm.RawRowView(1), 1e-9)
+
+	sm, err = Softmax(Dim(5), mx)
+	assert.Nil(sm)
+	assert.True(errors.Is(err, ErrInvalidDim))
+
+	lsm, err = LogSoftmax(Cols, &mat.Dense{})
+	assert.Nil(lsm)
+	assert.True(errors.Is(err, ErrZeroSize))
+}
@@ -0,0 +1,168 @@
+package matrix
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// NewDenseToeplitz returns a Toeplitz matrix with first column c and first row r.
+// The first element of r is ignored; the top left element of the matrix is c[0].
+// If r is nil, c is used as the first row, which yields a symmetric Toeplitz matrix.
+// NewDenseToeplitz fails if either c or r is empty.
+func NewDenseToeplitz(c, r []float64) (*mat.Dense, error) {
+	if r == nil {
+		r = c
+	}
+	rows, cols := len(c), len(r)
+	return withValidDims(rows, cols, func() (*mat.Dense, error) {
+		m := mat.NewDense(rows, cols, nil)
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				if i >= j {
+					m.Set(i, j, c[i-j])
+				} else {
+					m.Set(i, j, r[j-i])
+				}
+			}
+		}
+		return m, nil
+	})
+}
+
+// NewDenseHankel returns a Hankel matrix with first column c and last row r.
+// The first element of r is ignored; the bottom left element of the matrix is c[len(c)-1].
+// If r is nil, the elements below the anti-diagonal are set to zero and the
+// returned matrix is square.
+// NewDenseHankel fails if either c or r is empty.
+func NewDenseHankel(c, r []float64) (*mat.Dense, error) {
+	if r == nil {
+		r = make([]float64, len(c))
+	}
+	rows, cols := len(c), len(r)
+	return withValidDims(rows, cols, func() (*mat.Dense, error) {
+		m := mat.NewDense(rows, cols, nil)
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				if k := i + j; k < rows {
+					m.Set(i, j, c[k])
+				} else {
+					m.Set(i, j, r[k-rows+1])
+				}
+			}
+		}
+		return m, nil
+	})
+}
+
+// NewDenseCirculant returns a circulant matrix whose first column is c.
+// Each subsequent column is the previous column cyclically shifted down by one element.
+// NewDenseCirculant fails if c is empty.
+func NewDenseCirculant(c []float64) (*mat.Dense, error) {
+	n := len(c)
+	return withValidDims(n, n, func() (*mat.Dense, error) {
+		m := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				m.Set(i, j, c[(n+i-j)%n])
+			}
+		}
+		return m, nil
+	})
+}
+
+// NewDenseVandermonde returns a Vandermonde matrix with len(x) rows and cols columns.
+// If increasing is true the j-th column is x^j, otherwise the j-th column is x^(cols-j-1).
+// NewDenseVandermonde fails if x is empty or cols is not positive.
+func NewDenseVandermonde(x []float64, cols int, increasing bool) (*mat.Dense, error) {
+	rows := len(x)
+	return withValidDims(rows, cols, func() (*mat.Dense, error) {
+		m := mat.NewDense(rows, cols, nil)
+		for i := 0; i < rows; i++ {
+			p := 1.0
+			for j := 0; j < cols; j++ {
+				if increasing {
+					m.Set(i, j, p)
+				} else {
+					m.Set(i, cols-j-1, p)
+				}
+				p *= x[i]
+			}
+		}
+		return m, nil
+	})
+}
+
+// NewDenseHilbert returns n x n Hilbert matrix whose elements are 1/(i+j+1).
+// NewDenseHilbert fails if n is not positive.
+func NewDenseHilbert(n int) (*mat.Dense, error) {
+	return withValidDims(n, n, func() (*mat.Dense, error) {
+		m := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				m.Set(i, j, 1/float64(i+j+1))
+			}
+		}
+		return m, nil
+	})
+}
+
+// NewDensePascal returns n x n symmetric Pascal matrix whose elements
+// are binomial coefficients (i+j choose i).
+// NewDensePascal fails if n is not positive.
+func NewDensePascal(n int) (*mat.Dense, error) {
+	return withValidDims(n, n, func() (*mat.Dense, error) {
+		m := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			m.Set(i, 0, 1.0)
+			m.Set(0, i, 1.0)
+		}
+		for i := 1; i < n; i++ {
+			for j := 1; j < n; j++ {
+				m.Set(i, j, m.At(i-1, j)+m.At(i, j-1))
+			}
+		}
+		return m, nil
+	})
+}
+
+// NewDenseCauchy returns len(x) x len(y) Cauchy matrix whose elements are 1/(x[i]-y[j]).
+// NewDenseCauchy fails if either x or y is empty or if x[i] == y[j] for any i and j.
+func NewDenseCauchy(x, y []float64) (*mat.Dense, error) {
+	rows, cols := len(x), len(y)
+	return withValidDims(rows, cols, func() (*mat.Dense, error) {
+		m := mat.NewDense(rows, cols, nil)
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				d := x[i] - y[j]
+				if d == 0 {
+					return nil, fmt.Errorf("equal elements x[%d] and y[%d]: %f", i, j, x[i])
+				}
+				m.Set(i, j, 1/d)
+			}
+		}
+		return m, nil
+	})
+}
+
+// NewDenseCompanion returns a companion matrix of the polynomial whose coefficients
+// are stored in a in order of decreasing powers. The first row of the matrix
+// is -a[1:]/a[0] and the first sub-diagonal is set to ones. The eigenvalues of
+// the returned matrix are the roots of the polynomial.
+// NewDenseCompanion fails if a has fewer than 2 elements or a[0] is zero.
+func NewDenseCompanion(a []float64) (*mat.Dense, error) {
+	n := len(a) - 1
+	return withValidDims(n, n, func() (*mat.Dense, error) {
+		if a[0] == 0 {
+			return nil, fmt.Errorf("invalid leading coefficient: %f", a[0])
+		}
+		m := mat.NewDense(n, n, nil)
+		for j := 0; j < n; j++ {
+			m.Set(0, j, -a[j+1]/a[0])
+		}
+		for i := 1; i < n; i++ {
+			m.Set(i, i-1, 1.0)
+		}
+		return m, nil
+	})
+}
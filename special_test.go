@@ -0,0 +1,188 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestNewDenseToeplitz(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDenseToeplitz([]float64{1, 2, 3}, []float64{9, 4, 5, 6})
+	assert.NoError(err)
+	exp := mat.NewDense(3, 4, []float64{
+		1, 4, 5, 6,
+		2, 1, 4, 5,
+		3, 2, 1, 4,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseToeplitz([]float64{1, 2, 3}, nil)
+	assert.NoError(err)
+	exp = mat.NewDense(3, 3, []float64{
+		1, 2, 3,
+		2, 1, 2,
+		3, 2, 1,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseToeplitz(nil, []float64{1})
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseHankel(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDenseHankel([]float64{1, 2, 3}, []float64{9, 4, 5, 6})
+	assert.NoError(err)
+	exp := mat.NewDense(3, 4, []float64{
+		1, 2, 3, 4,
+		2, 3, 4, 5,
+		3, 4, 5, 6,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseHankel([]float64{1, 2, 3}, nil)
+	assert.NoError(err)
+	exp = mat.NewDense(3, 3, []float64{
+		1, 2, 3,
+		2, 3, 0,
+		3, 0, 0,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseHankel(nil, nil)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseCirculant(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDenseCirculant([]float64{1, 2, 3})
+	assert.NoError(err)
+	exp := mat.NewDense(3, 3, []float64{
+		1, 3, 2,
+		2, 1, 3,
+		3, 2, 1,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseCirculant(nil)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseVandermonde(t *testing.T) {
+	assert := assert.New(t)
+
+	x := []float64{1, 2, 3}
+	m, err := NewDenseVandermonde(x, 3, true)
+	assert.NoError(err)
+	exp := mat.NewDense(3, 3, []float64{
+		1, 1, 1,
+		1, 2, 4,
+		1, 3, 9,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseVandermonde(x, 2, false)
+	assert.NoError(err)
+	exp = mat.NewDense(3, 2, []float64{
+		1, 1,
+		2, 1,
+		3, 1,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseVandermonde(x, 0, false)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseHilbert(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDenseHilbert(3)
+	assert.NoError(err)
+	exp := mat.NewDense(3, 3, []float64{
+		1, 1.0 / 2, 1.0 / 3,
+		1.0 / 2, 1.0 / 3, 1.0 / 4,
+		1.0 / 3, 1.0 / 4, 1.0 / 5,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseHilbert(0)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDensePascal(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDensePascal(4)
+	assert.NoError(err)
+	exp := mat.NewDense(4, 4, []float64{
+		1, 1, 1, 1,
+		1, 2, 3, 4,
+		1, 3, 6, 10,
+		1, 4, 10, 20,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDensePascal(-1)
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseCauchy(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewDenseCauchy([]float64{1, 2}, []float64{0, -1, 3})
+	assert.NoError(err)
+	exp := mat.NewDense(2, 3, []float64{
+		1, 1.0 / 2, -1.0 / 2,
+		1.0 / 2, 1.0 / 3, -1,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	m, err = NewDenseCauchy([]float64{1, 2}, []float64{2})
+	assert.Nil(m)
+	assert.Error(err)
+
+	m, err = NewDenseCauchy(nil, []float64{2})
+	assert.Nil(m)
+	assert.Error(err)
+}
+
+func TestNewDenseCompanion(t *testing.T) {
+	assert := assert.New(t)
+
+	// (x-1)(x-2)(x-3) = x^3 - 6x^2 + 11x - 6
+	m, err := NewDenseCompanion([]float64{2, -12, 22, -12})
+	assert.NoError(err)
+	exp := mat.NewDense(3, 3, []float64{
+		6, -11, 6,
+		1, 0, 0,
+		0, 1, 0,
+	})
+	assert.True(mat.Equal(exp, m))
+
+	var eig mat.Eigen
+	assert.True(eig.Factorize(m, mat.EigenNone))
+	for _, v := range eig.Values(nil) {
+		assert.InDelta(0.0, imag(v), 1e-10)
+		assert.InDelta(0.0, (real(v)-1)*(real(v)-2)*(real(v)-3), 1e-10)
+	}
+
+	m, err = NewDenseCompanion([]float64{1})
+	assert.Nil(m)
+	assert.Error(err)
+
+	m, err = NewDenseCompanion([]float64{0, 1, 2})
+	assert.Nil(m)
+	assert.Error(err)
+}
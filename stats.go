@@ -0,0 +1,449 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+// ColsVariance returns a slice of variances of first cols matrix columns.
+// The variance is normalized by N-ddof where N is the number of observations i.e. matrix rows:
+// ddof 0 gives population variance and ddof 1 gives unbiased sample variance.
+// If weights is not nil, each observation is weighted by the corresponding weight
+// and N is the sum of weights.
+// It returns error if passed in matrix is nil or has zero size, requested number of columns
+// exceeds the number of columns in matrix m, ddof is negative or not smaller than N
+// or weights length does not match the number of matrix rows.
+func ColsVariance(cols int, m mat.Matrix, ddof int, weights []float64) ([]float64, error) {
+	return Variance(Cols, cols, m, ddof, weights)
+}
+
+// RowsVariance returns a slice of variances of first rows matrix rows.
+// The variance is normalized by N-ddof where N is the number of observations i.e. matrix columns:
+// ddof 0 gives population variance and ddof 1 gives unbiased sample variance.
+// If weights is not nil, each observation is weighted by the corresponding weight
+// and N is the sum of weights.
+// It returns error if passed in matrix is nil or has zero size, requested number of rows
+// exceeds the number of rows in matrix m, ddof is negative or not smaller than N
+// or weights length does not match the number of matrix columns.
+func RowsVariance(rows int, m mat.Matrix, ddof int, weights []float64) ([]float64, error) {
+	return Variance(Rows, rows, m, ddof, weights)
+}
+
+// Variance returns a slice of variances of first count matrix rows or columns as selected by dim.
+// The variance is normalized by N-ddof where N is the number of observations in each row or column.
+// If weights is not nil, each observation is weighted by the corresponding weight and N is the sum of weights.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid, requested count exceeds
+// the size of the matrix dimension, ddof is negative or not smaller than N or weights length
+// does not match the number of observations.
+func Variance(dim Dim, count int, m mat.Matrix, ddof int, weights []float64) ([]float64, error) {
+	if err := validVarArgs(dim, m, ddof, weights); err != nil {
+		return nil, err
+	}
+	if err := validVarDof(dim, m, ddof, weights); err != nil {
+		return nil, err
+	}
+	return withValidDim(dim, count, m, func(v mat.Matrix) float64 {
+		return variance(v, ddof, weights)
+	})
+}
+
+// Stdev returns a slice of standard deviations of first count matrix rows or columns as selected by dim.
+// The underlying variance is normalized by N-ddof where N is the number of observations in each row or column.
+// If weights is not nil, each observation is weighted by the corresponding weight and N is the sum of weights.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid, requested count exceeds
+// the size of the matrix dimension, ddof is negative or not smaller than N or weights length
+// does not match the number of observations.
+func Stdev(dim Dim, count int, m mat.Matrix, ddof int, weights []float64) ([]float64, error) {
+	res, err := Variance(dim, count, m, ddof, weights)
+	if err != nil {
+		return nil, err
+	}
+	for i := range res {
+		res[i] = math.Sqrt(res[i])
+	}
+	return res, nil
+}
+
+// validVarArgs validates variance arguments.
+// It returns error if ddof is negative or weights length does not match the number of
+// observations stored in matrix m along dimension dim. Matrix m itself is not validated.
+func validVarArgs(dim Dim, m mat.Matrix, ddof int, weights []float64) error {
+	if ddof < 0 {
+		return fmt.Errorf("invalid delta degrees of freedom: %d", ddof)
+	}
+	if weights == nil || isNil(m) {
+		return nil
+	}
+	rows, cols := m.Dims()
+	n := cols
+	if dim == Cols {
+		n = rows
+	}
+	if len(weights) != n {
+		return fmt.Errorf("%w: weights length: %d, observations: %d", ErrDimMismatch, len(weights), n)
+	}
+	return nil
+}
+
+// validVarDof returns error if ddof is not smaller than N, the number of observations stored
+// in matrix m along dimension dim or the sum of weights, so the variance would be normalized
+// by zero or a negative number. Invalid matrix m is not validated.
+func validVarDof(dim Dim, m mat.Matrix, ddof int, weights []float64) error {
+	if validMatrix(m) != nil {
+		return nil
+	}
+	rows, cols := m.Dims()
+	n := float64(cols)
+	if dim == Cols {
+		n = float64(rows)
+	}
+	if weights != nil {
+		n = floats.Sum(weights)
+	}
+	if !(n-float64(ddof) > 0) {
+		return fmt.Errorf("invalid delta degrees of freedom: %d, observations: %f", ddof, n)
+	}
+	return nil
+}
+
+// variance returns variance of all elements of matrix m normalized by N-ddof.
+// If weights is not nil, each element is weighted by the corresponding weight and N is the sum of weights.
+// The elements of m are read in row-major order.
+func variance(m mat.Matrix, ddof int, weights []float64) float64 {
+	x := values(m)
+	mu := stat.Mean(x, weights)
+
+	var ss, comp, sumW float64
+	if weights == nil {
+		for _, v := range x {
+			d := v - mu
+			ss += d * d
+			comp += d
+		}
+		sumW = float64(len(x))
+	} else {
+		for i, v := range x {
+			d := v - mu
+			ss += weights[i] * d * d
+			comp += weights[i] * d
+			sumW += weights[i]
+		}
+	}
+
+	// compensated summation, see stat.Variance
+	return (ss - comp*comp/sumW) / (sumW - float64(ddof))
+}
+
+// values returns all elements of matrix m in row-major order.
+// Contiguous vectors return their underlying data without copying.
+func values(m mat.Matrix) []float64 {
+	if rv, ok := m.(mat.RawVectorer); ok {
+		if raw := rv.RawVector(); raw.Inc == 1 {
+			return raw.Data[:raw.N]
+		}
+	}
+	return Unroll(m, true).RawVector().Data
+}
+
+// ColsMedian returns a slice of medians of first cols matrix columns.
+// The median of even number of values is the mean of the two middle values.
+// It returns error if passed in matrix is nil or has zero size or requested number
+// of columns exceeds the number of columns in matrix m.
+func ColsMedian(cols int, m mat.Matrix) ([]float64, error) {
+	return Median(Cols, cols, m)
+}
+
+// RowsMedian returns a slice of medians of first rows matrix rows.
+// The median of even number of values is the mean of the two middle values.
+// It returns error if passed in matrix is nil or has zero size or requested number
+// of rows exceeds the number of rows in matrix m.
+func RowsMedian(rows int, m mat.Matrix) ([]float64, error) {
+	return Median(Rows, rows, m)
+}
+
+// Median returns a slice of medians of first count matrix rows or columns as selected by dim.
+// The median of even number of values is the mean of the two middle values.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or requested
+// count exceeds the size of the matrix dimension.
+func Median(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, sorted(median))
+}
+
+// ColsQuantile returns a slice of p-quantiles of first cols matrix columns.
+// The quantiles are computed by stat.Quantile using the cumulant kind c.
+// It returns error if passed in matrix is nil or has zero size, requested number of columns
+// exceeds the number of columns in matrix m, p is not in interval [0, 1] or c is not supported.
+func ColsQuantile(cols int, m mat.Matrix, p float64, c stat.CumulantKind) ([]float64, error) {
+	return Quantile(Cols, cols, m, p, c)
+}
+
+// RowsQuantile returns a slice of p-quantiles of first rows matrix rows.
+// The quantiles are computed by stat.Quantile using the cumulant kind c.
+// It returns error if passed in matrix is nil or has zero size, requested number of rows
+// exceeds the number of rows in matrix m, p is not in interval [0, 1] or c is not supported.
+func RowsQuantile(rows int, m mat.Matrix, p float64, c stat.CumulantKind) ([]float64, error) {
+	return Quantile(Rows, rows, m, p, c)
+}
+
+// Quantile returns a slice of p-quantiles of first count matrix rows or columns as selected by dim.
+// The quantiles are computed by stat.Quantile using the cumulant kind c.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid, requested count exceeds
+// the size of the matrix dimension, p is not in interval [0, 1] or c is not supported.
+func Quantile(dim Dim, count int, m mat.Matrix, p float64, c stat.CumulantKind) ([]float64, error) {
+	if err := validQuantileArgs(p, c); err != nil {
+		return nil, err
+	}
+	return withValidDim(dim, count, m, sorted(func(x []float64) float64 {
+		return stat.Quantile(p, c, x, nil)
+	}))
+}
+
+// Percentile returns a slice of q-th percentiles of first count matrix rows or columns as selected by dim.
+// The percentiles are computed by stat.Quantile using the cumulant kind c.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid, requested count exceeds
+// the size of the matrix dimension, q is not in interval [0, 100] or c is not supported.
+func Percentile(dim Dim, count int, m mat.Matrix, q float64, c stat.CumulantKind) ([]float64, error) {
+	if !(q >= 0 && q <= 100) {
+		return nil, fmt.Errorf("invalid percentile: %f", q)
+	}
+	return Quantile(dim, count, m, q/100, c)
+}
+
+// ColsIQR returns a slice of interquartile ranges of first cols matrix columns.
+// The quartiles are computed by stat.Quantile using the cumulant kind c.
+// It returns error if passed in matrix is nil or has zero size, requested number of columns
+// exceeds the number of columns in matrix m or c is not supported.
+func ColsIQR(cols int, m mat.Matrix, c stat.CumulantKind) ([]float64, error) {
+	return IQR(Cols, cols, m, c)
+}
+
+// RowsIQR returns a slice of interquartile ranges of first rows matrix rows.
+// The quartiles are computed by stat.Quantile using the cumulant kind c.
+// It returns error if passed in matrix is nil or has zero size, requested number of rows
+// exceeds the number of rows in matrix m or c is not supported.
+func RowsIQR(rows int, m mat.Matrix, c stat.CumulantKind) ([]float64, error) {
+	return IQR(Rows, rows, m, c)
+}
+
+// IQR returns a slice of interquartile ranges of first count matrix rows or columns as selected by dim.
+// The interquartile range is the difference between 0.75 and 0.25 quantiles computed
+// by stat.Quantile using the cumulant kind c.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid, requested count exceeds
+// the size of the matrix dimension or c is not supported.
+func IQR(dim Dim, count int, m mat.Matrix, c stat.CumulantKind) ([]float64, error) {
+	if err := validQuantileArgs(0.5, c); err != nil {
+		return nil, err
+	}
+	return withValidDim(dim, count, m, sorted(func(x []float64) float64 {
+		return stat.Quantile(0.75, c, x, nil) - stat.Quantile(0.25, c, x, nil)
+	}))
+}
+
+// validQuantileArgs returns error if p is not in interval [0, 1] or c is not supported
+func validQuantileArgs(p float64, c stat.CumulantKind) error {
+	if !(p >= 0 && p <= 1) {
+		return fmt.Errorf("invalid quantile: %f", p)
+	}
+	if c != stat.Empirical && c != stat.LinInterp {
+		return fmt.Errorf("invalid cumulant kind: %d", c)
+	}
+	return nil
+}
+
+// sorted returns a function which copies all elements of a matrix into a buffer,
+// sorts them in increasing order and returns the result of fn applied to them.
+// The buffer is reused between calls.
+func sorted(fn func([]float64) float64) func(mat.Matrix) float64 {
+	var buf []float64
+	return func(m mat.Matrix) float64 {
+		buf = sortedValues(buf, m)
+		return fn(buf)
+	}
+}
+
+// sortedValues copies all elements of matrix m into buf, sorts them in increasing
+// order and returns the resulting slice. buf is grown if it is too small.
+func sortedValues(buf []float64, m mat.Matrix) []float64 {
+	x := values(m)
+	if cap(buf) < len(x) {
+		buf = make([]float64, len(x))
+	}
+	buf = buf[:len(x)]
+	copy(buf, x)
+	sort.Float64s(buf)
+	return buf
+}
+
+// median returns the median of sorted values x
+func median(x []float64) float64 {
+	if floats.HasNaN(x) {
+		return math.NaN()
+	}
+	n := len(x)
+	if n%2 == 1 {
+		return x[n/2]
+	}
+	return (x[n/2-1] + x[n/2]) / 2
+}
+
+// ColsSkew returns a slice of sample skewness of first cols matrix columns.
+// It returns error if passed in matrix is nil or has zero size or requested number
+// of columns exceeds the number of columns in matrix m.
+func ColsSkew(cols int, m mat.Matrix) ([]float64, error) {
+	return Skew(Cols, cols, m)
+}
+
+// RowsSkew returns a slice of sample skewness of first rows matrix rows.
+// It returns error if passed in matrix is nil or has zero size or requested number
+// of rows exceeds the number of rows in matrix m.
+func RowsSkew(rows int, m mat.Matrix) ([]float64, error) {
+	return Skew(Rows, rows, m)
+}
+
+// Skew returns a slice of sample skewness of first count matrix rows or columns as selected by dim.
+// The skewness is computed by stat.Skew.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or requested
+// count exceeds the size of the matrix dimension.
+func Skew(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, func(v mat.Matrix) float64 {
+		return stat.Skew(values(v), nil)
+	})
+}
+
+// ColsExKurtosis returns a slice of sample excess kurtosis of first cols matrix columns.
+// It returns error if passed in matrix is nil or has zero size or requested number
+// of columns exceeds the number of columns in matrix m.
+func ColsExKurtosis(cols int, m mat.Matrix) ([]float64, error) {
+	return ExKurtosis(Cols, cols, m)
+}
+
+// RowsExKurtosis returns a slice of sample excess kurtosis of first rows matrix rows.
+// It returns error if passed in matrix is nil or has zero size or requested number
+// of rows exceeds the number of rows in matrix m.
+func RowsExKurtosis(rows int, m mat.Matrix) ([]float64, error) {
+	return ExKurtosis(Rows, rows, m)
+}
+
+// ExKurtosis returns a slice of sample excess kurtosis of first count matrix rows or columns
+// as selected by dim. The excess kurtosis is computed by stat.ExKurtosis.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or requested
+// count exceeds the size of the matrix dimension.
+func ExKurtosis(dim Dim, count int, m mat.Matrix) ([]float64, error) {
+	return withValidDim(dim, count, m, func(v mat.Matrix) float64 {
+		return stat.ExKurtosis(values(v), nil)
+	})
+}
+
+// Summary contains descriptive statistics of matrix rows or columns.
+// The i-th element of each slice holds the statistic of the i-th row or column.
+type Summary struct {
+	// Count is the number of observations
+	Count []int
+	// Mean is the mean of observations
+	Mean []float64
+	// Stdev is the sample standard deviation of observations
+	Stdev []float64
+	// Min is the min value of observations
+	Min []float64
+	// Q1 is the first quartile of observations
+	Q1 []float64
+	// Median is the median of observations
+	Median []float64
+	// Q3 is the third quartile of observations
+	Q3 []float64
+	// Max is the max value of observations
+	Max []float64
+}
+
+// Describe returns descriptive statistics of all matrix rows or columns as selected by dim.
+// The first and third quartiles are computed by stat.Quantile using stat.LinInterp cumulant kind,
+// so they match Quantile with the same kind, and the median matches Median.
+// Each row or column is copied and sorted only once to compute all its statistics.
+// NaN values are propagated: all statistics of a row or column which contains NaN are NaN
+// except for Count, which is the number of all its values.
+// It returns error if passed in matrix is nil, has zero size or dim is invalid.
+func Describe(m mat.Matrix, dim Dim) (*Summary, error) {
+	n, err := dimLen(dim, m)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Summary{
+		Count:  make([]int, n),
+		Mean:   make([]float64, n),
+		Stdev:  make([]float64, n),
+		Min:    make([]float64, n),
+		Q1:     make([]float64, n),
+		Median: make([]float64, n),
+		Q3:     make([]float64, n),
+		Max:    make([]float64, n),
+	}
+
+	var x []float64
+	view := dimView(dim, m)
+	for i := 0; i < n; i++ {
+		x = sortedValues(x, view(i))
+		s.Count[i] = len(x)
+		s.Mean[i], s.Stdev[i] = stat.MeanStdDev(x, nil)
+		s.Min[i], s.Max[i] = x[0], x[len(x)-1]
+		s.Q1[i] = stat.Quantile(0.25, stat.LinInterp, x, nil)
+		s.Median[i] = median(x)
+		s.Q3[i] = stat.Quantile(0.75, stat.LinInterp, x, nil)
+		if floats.HasNaN(x) {
+			nan := math.NaN()
+			s.Min[i], s.Q1[i], s.Q3[i], s.Max[i] = nan, nan, nan, nan
+		}
+	}
+
+	return s, nil
+}
+
+// WeightedMean returns a slice of weighted means of first count matrix rows or columns as selected by dim.
+// Each observation in a row or column is weighted by the corresponding weight. If weights is nil
+// all observations are weighted equally. Weighted variance and standard deviation are provided
+// by Variance and Stdev.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid, requested count exceeds
+// the size of the matrix dimension or weights length does not match the number of observations.
+func WeightedMean(dim Dim, count int, m mat.Matrix, weights []float64) ([]float64, error) {
+	if err := validVarArgs(dim, m, 0, weights); err != nil {
+		return nil, err
+	}
+	return withValidDim(dim, count, m, func(v mat.Matrix) float64 {
+		return stat.Mean(values(v), weights)
+	})
+}
+
+// WeightedCov calculates a weighted sample covariance matrix of data stored in m along dim dimension.
+// If dim is Cols, each matrix row is a variable whose observations are stored in columns; if dim is Rows,
+// each matrix column is a variable whose observations are stored in rows. Each observation is weighted
+// by the corresponding weight and the covariance is normalized by the sum of weights minus one.
+// If weights is nil all observations are weighted equally.
+// It returns error if passed in matrix is nil, has zero size, dim is invalid or weights length
+// does not match the number of observations.
+func WeightedCov(m mat.Matrix, dim Dim, weights []float64) (*mat.SymDense, error) {
+	if err := validMatrix(m); err != nil {
+		return nil, err
+	}
+	if err := dim.validate(); err != nil {
+		return nil, err
+	}
+
+	// stat.CovarianceMatrix expects observations stored in rows
+	x := m
+	if dim == Cols {
+		x = m.T()
+	}
+	if err := validVarArgs(Cols, x, 0, weights); err != nil {
+		return nil, err
+	}
+
+	cov := &mat.SymDense{}
+	stat.CovarianceMatrix(cov, x, weights)
+
+	return cov, nil
+}
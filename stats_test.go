@@ -0,0 +1,449 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func TestVariance(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1.2, 3.4, 5.6,
+		4.5, 6.7, 7.1,
+		8.9, 10.0, 2.3,
+		0.5, -1.0, 4.4,
+	}
+	mx := mat.NewDense(4, 3, data)
+	rows, cols := mx.Dims()
+	delta := 1e-12
+
+	colWeights := []float64{1, 2, 0.5, 3}
+	rowWeights := []float64{0.2, 1, 4}
+
+	// expected values are calculated from copies of the rows and columns
+	for _, ddof := range []int{0, 1} {
+		for _, weights := range [][]float64{nil, colWeights} {
+			res, err := ColsVariance(cols, mx, ddof, weights)
+			assert.NoError(err)
+			for j := 0; j < cols; j++ {
+				exp := expVariance(mat.Col(nil, j, mx), ddof, weights)
+				assert.InDelta(exp, res[j], delta)
+			}
+
+			sd, err := Stdev(Cols, cols, mx, ddof, weights)
+			assert.NoError(err)
+			for j := range sd {
+				assert.InDelta(math.Sqrt(res[j]), sd[j], delta)
+			}
+		}
+
+		for _, weights := range [][]float64{nil, rowWeights} {
+			res, err := RowsVariance(rows, mx, ddof, weights)
+			assert.NoError(err)
+			for i := 0; i < rows; i++ {
+				exp := expVariance(mat.Row(nil, i, mx), ddof, weights)
+				assert.InDelta(exp, res[i], delta)
+			}
+		}
+	}
+
+	// sample variance matches gonum stat package
+	res, err := Variance(Cols, cols, mx, 1, colWeights)
+	assert.NoError(err)
+	for j := 0; j < cols; j++ {
+		assert.InDelta(stat.Variance(mat.Col(nil, j, mx), colWeights), res[j], delta)
+	}
+
+	// standard deviations are consistent with ColsStdev and RowsStdev
+	sd, err := Stdev(Cols, cols, mx, 1, nil)
+	assert.NoError(err)
+	exp, _ := ColsStdev(cols, mx)
+	assert.InDeltaSlice(exp, sd, delta)
+
+	sd, err = Stdev(Rows, rows, mx, 1, nil)
+	assert.NoError(err)
+	exp, _ = RowsStdev(rows, mx)
+	assert.InDeltaSlice(exp, sd, delta)
+
+	// invalid ddof
+	res, err = ColsVariance(cols, mx, -1, nil)
+	assert.Nil(res)
+	assert.Error(err)
+
+	// ddof not smaller than the number of observations
+	col := mat.NewDense(5, 1, []float64{1, 2, 3, 4, 10})
+	for _, ddof := range []int{5, 6} {
+		res, err = ColsVariance(1, col, ddof, nil)
+		assert.Nil(res)
+		assert.Error(err)
+
+		res, err = Stdev(Cols, 1, col, ddof, nil)
+		assert.Nil(res)
+		assert.Error(err)
+	}
+
+	res, err = ColsVariance(1, col, 4, nil)
+	assert.NoError(err)
+	assert.False(math.IsInf(res[0], 0))
+
+	// ddof not smaller than the sum of weights
+	res, err = RowsVariance(rows, mx, 2, []float64{0.5, 0.5, 1})
+	assert.Nil(res)
+	assert.Error(err)
+
+	// weights length mismatch
+	res, err = ColsVariance(cols, mx, 0, rowWeights)
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	res, err = RowsVariance(rows, mx, 0, colWeights)
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	// nil matrix
+	res, err = RowsVariance(rows, nil, 0, colWeights)
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrNilMatrix))
+
+	// invalid dimension
+	res, err = Variance(Dim(3), 1, mx, 0, nil)
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrInvalidDim))
+}
+
+// expVariance computes variance of x using textbook two pass algorithm
+func expVariance(x []float64, ddof int, weights []float64) float64 {
+	var sum, sumW float64
+	for i, v := range x {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		sum += w * v
+		sumW += w
+	}
+	mean := sum / sumW
+
+	var ss float64
+	for i, v := range x {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		ss += w * (v - mean) * (v - mean)
+	}
+	return ss / (sumW - float64(ddof))
+}
+
+func TestMedian(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		3, 1, 2,
+		9, 7, 8,
+		4, 6, 5,
+		0, 10, 2,
+	}
+	mx := mat.NewDense(4, 3, data)
+	rows, cols := mx.Dims()
+
+	med, err := ColsMedian(cols, mx)
+	assert.NoError(err)
+	assert.Equal([]float64{3.5, 6.5, 3.5}, med)
+
+	med, err = RowsMedian(rows, mx)
+	assert.NoError(err)
+	assert.Equal([]float64{2, 8, 5, 2}, med)
+
+	// the matrix must not be modified
+	assert.True(mat.Equal(mat.NewDense(4, 3, []float64{
+		3, 1, 2,
+		9, 7, 8,
+		4, 6, 5,
+		0, 10, 2,
+	}), mx))
+
+	nanMx := mat.NewDense(2, 1, []float64{1, math.NaN()})
+	med, err = ColsMedian(1, nanMx)
+	assert.NoError(err)
+	assert.True(math.IsNaN(med[0]))
+
+	med, err = Median(Rows, rows+1, mx)
+	assert.Nil(med)
+	assert.True(errors.Is(err, ErrDimMismatch))
+}
+
+func TestQuantile(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		3, 1,
+		9, 7,
+		4, 6,
+		0, 10,
+		5, 2,
+	}
+	mx := mat.NewDense(5, 2, data)
+	rows, cols := mx.Dims()
+
+	for _, c := range []stat.CumulantKind{stat.Empirical, stat.LinInterp} {
+		for _, p := range []float64{0, 0.1, 0.25, 0.5, 0.9, 1} {
+			res, err := ColsQuantile(cols, mx, p, c)
+			assert.NoError(err)
+			for j := 0; j < cols; j++ {
+				x := mat.Col(nil, j, mx)
+				sort.Float64s(x)
+				assert.Equal(stat.Quantile(p, c, x, nil), res[j])
+			}
+
+			pct, err := Percentile(Cols, cols, mx, p*100, c)
+			assert.NoError(err)
+			assert.InDeltaSlice(res, pct, 1e-12)
+		}
+
+		res, err := RowsQuantile(rows, mx, 0.5, c)
+		assert.NoError(err)
+		for i := 0; i < rows; i++ {
+			x := mat.Row(nil, i, mx)
+			sort.Float64s(x)
+			assert.Equal(stat.Quantile(0.5, c, x, nil), res[i])
+		}
+
+		iqr, err := ColsIQR(cols, mx, c)
+		assert.NoError(err)
+		q1, _ := ColsQuantile(cols, mx, 0.25, c)
+		q3, _ := ColsQuantile(cols, mx, 0.75, c)
+		for j := range iqr {
+			assert.Equal(q3[j]-q1[j], iqr[j])
+		}
+
+		iqr, err = RowsIQR(rows, mx, c)
+		assert.NoError(err)
+		assert.Len(iqr, rows)
+	}
+
+	res, err := ColsQuantile(cols, mx, 1.5, stat.Empirical)
+	assert.Nil(res)
+	assert.Error(err)
+
+	res, err = Quantile(Cols, cols, mx, 0.5, stat.CumulantKind(0))
+	assert.Nil(res)
+	assert.Error(err)
+
+	res, err = Percentile(Cols, cols, mx, -1, stat.Empirical)
+	assert.Nil(res)
+	assert.Error(err)
+
+	res, err = IQR(Cols, cols, nil, stat.Empirical)
+	assert.Nil(res)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
+
+func TestSkewExKurtosis(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1.2, 3.4, 5.6, 0.1,
+		4.5, 6.7, 7.1, 2.2,
+		8.9, 10.0, 2.3, -3.0,
+		0.5, -1.0, 4.4, 1.1,
+		2.5, 3.0, 14.4, 9.9,
+	}
+	mx := mat.NewDense(5, 4, data)
+	rows, cols := mx.Dims()
+
+	skew, err := ColsSkew(cols, mx)
+	assert.NoError(err)
+	kurt, err := ColsExKurtosis(cols, mx)
+	assert.NoError(err)
+	for j := 0; j < cols; j++ {
+		x := mat.Col(nil, j, mx)
+		assert.InDelta(stat.Skew(x, nil), skew[j], 1e-12)
+		assert.InDelta(stat.ExKurtosis(x, nil), kurt[j], 1e-12)
+	}
+
+	skew, err = RowsSkew(rows, mx)
+	assert.NoError(err)
+	kurt, err = RowsExKurtosis(rows, mx)
+	assert.NoError(err)
+	for i := 0; i < rows; i++ {
+		x := mat.Row(nil, i, mx)
+		assert.InDelta(stat.Skew(x, nil), skew[i], 1e-12)
+		assert.InDelta(stat.ExKurtosis(x, nil), kurt[i], 1e-12)
+	}
+
+	skew, err = Skew(Cols, cols+1, mx)
+	assert.Nil(skew)
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	kurt, err = ExKurtosis(Rows, rows, nil)
+	assert.Nil(kurt)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}
+
+func TestDescribe(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		3, 1,
+		9, 7,
+		4, 6,
+		0, 10,
+	}
+	mx := mat.NewDense(4, 2, data)
+	delta := 1e-12
+
+	s, err := Describe(mx, Cols)
+	assert.NoError(err)
+	assert.Equal([]int{4, 4}, s.Count)
+	assert.InDeltaSlice([]float64{4, 6}, s.Mean, delta)
+	sd, _ := ColsStdev(2, mx)
+	assert.InDeltaSlice(sd, s.Stdev, delta)
+	assert.Equal([]float64{0, 1}, s.Min)
+	assert.InDeltaSlice([]float64{3.5, 6.5}, s.Median, delta)
+	assert.Equal([]float64{9, 10}, s.Max)
+
+	// quartiles match Quantile with stat.LinInterp and median matches Median reduction
+	q1, _ := ColsQuantile(2, mx, 0.25, stat.LinInterp)
+	assert.Equal(q1, s.Q1)
+	q3, _ := ColsQuantile(2, mx, 0.75, stat.LinInterp)
+	assert.Equal(q3, s.Q3)
+	med, _ := ColsMedian(2, mx)
+	assert.Equal(med, s.Median)
+
+	s, err = Describe(mx, Rows)
+	assert.NoError(err)
+	assert.Equal([]int{2, 2, 2, 2}, s.Count)
+	assert.Equal([]float64{1, 7, 4, 0}, s.Min)
+	assert.Equal([]float64{3, 9, 6, 10}, s.Max)
+	q1, _ = RowsQuantile(4, mx, 0.25, stat.LinInterp)
+	assert.Equal(q1, s.Q1)
+	q3, _ = RowsQuantile(4, mx, 0.75, stat.LinInterp)
+	assert.Equal(q3, s.Q3)
+
+	// quartiles of a skewed column
+	sk := mat.NewDense(5, 1, []float64{1, 2, 3, 4, 10})
+	s, err = Describe(sk, Cols)
+	assert.NoError(err)
+	assert.InDeltaSlice([]float64{1.25}, s.Q1, delta)
+	assert.InDeltaSlice([]float64{3}, s.Median, delta)
+	iqr, _ := ColsIQR(1, sk, stat.LinInterp)
+	assert.InDeltaSlice(iqr, []float64{s.Q3[0] - s.Q1[0]}, delta)
+
+	// the matrix must not be modified
+	assert.True(mat.Equal(mat.NewDense(4, 2, []float64{3, 1, 9, 7, 4, 6, 0, 10}), mx))
+
+	// NaN values are propagated to all statistics
+	nan := math.NaN()
+	s, err = Describe(mat.NewDense(3, 2, []float64{nan, 1, 1, 2, 2, 3}), Cols)
+	assert.NoError(err)
+	assert.Equal([]int{3, 3}, s.Count)
+	for _, v := range [][]float64{s.Mean, s.Stdev, s.Min, s.Q1, s.Median, s.Q3, s.Max} {
+		assert.True(math.IsNaN(v[0]))
+		assert.False(math.IsNaN(v[1]))
+	}
+	assert.Equal(1.0, s.Min[1])
+	assert.Equal(3.0, s.Max[1])
+
+	s, err = Describe(mx, Dim(0))
+	assert.Nil(s)
+	assert.True(errors.Is(err, ErrInvalidDim))
+
+	s, err = Describe(&mat.Dense{}, Cols)
+	assert.Nil(s)
+	assert.True(errors.Is(err, ErrZeroSize))
+}
+
+func TestWeightedStats(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []float64{
+		1.2, 3.4, 5.6,
+		4.5, 6.7, 7.1,
+		8.9, 10.0, 2.3,
+		0.5, -1.0, 4.4,
+	}
+	mx := mat.NewDense(4, 3, data)
+	rows, cols := mx.Dims()
+	delta := 1e-12
+
+	colWeights := []float64{1, 2, 0.5, 3}
+	rowWeights := []float64{0.2, 1, 4}
+
+	mean, err := WeightedMean(Cols, cols, mx, colWeights)
+	assert.NoError(err)
+	for j := 0; j < cols; j++ {
+		assert.InDelta(stat.Mean(mat.Col(nil, j, mx), colWeights), mean[j], delta)
+	}
+
+	mean, err = WeightedMean(Rows, rows, mx, rowWeights)
+	assert.NoError(err)
+	for i := 0; i < rows; i++ {
+		assert.InDelta(stat.Mean(mat.Row(nil, i, mx), rowWeights), mean[i], delta)
+	}
+
+	// nil weights give unweighted mean
+	mean, err = WeightedMean(Cols, cols, mx, nil)
+	assert.NoError(err)
+	exp, _ := ColsMean(cols, mx)
+	assert.InDeltaSlice(exp, mean, delta)
+
+	// unit weights give unweighted covariance
+	cov, err := WeightedCov(mx, Cols, []float64{1, 1, 1})
+	assert.NoError(err)
+	expCov, _ := CovDim(mx, Cols)
+	assert.True(mat.EqualApprox(expCov, cov, delta))
+
+	// nil weights match CovDim along both dimensions
+	for _, dim := range []Dim{Rows, Cols} {
+		cov, err = WeightedCov(mx, dim, nil)
+		assert.NoError(err)
+		expCov, _ = CovDim(mx, dim)
+		assert.True(mat.EqualApprox(expCov, cov, delta))
+	}
+
+	cov, err = WeightedCov(mx, Rows, colWeights)
+	assert.NoError(err)
+	expCov = &mat.SymDense{}
+	stat.CovarianceMatrix(expCov, mx, colWeights)
+	assert.True(mat.EqualApprox(expCov, cov, delta))
+
+	cov, err = WeightedCov(mx, Cols, rowWeights)
+	assert.NoError(err)
+	expCov = &mat.SymDense{}
+	stat.CovarianceMatrix(expCov, mx.T(), rowWeights)
+	assert.True(mat.EqualApprox(expCov, cov, delta))
+
+	// weighted variance is consistent with weighted covariance
+	variance, err := Variance(Cols, cols, mx, 1, colWeights)
+	assert.NoError(err)
+	expCov = &mat.SymDense{}
+	stat.CovarianceMatrix(expCov, mx, colWeights)
+	for j := 0; j < cols; j++ {
+		assert.InDelta(expCov.At(j, j), variance[j], delta)
+	}
+
+	// weights length mismatch
+	mean, err = WeightedMean(Cols, cols, mx, rowWeights)
+	assert.Nil(mean)
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	cov, err = WeightedCov(mx, Rows, rowWeights)
+	assert.Nil(cov)
+	assert.True(errors.Is(err, ErrDimMismatch))
+
+	cov, err = WeightedCov(mx, Dim(3), rowWeights)
+	assert.Nil(cov)
+	assert.True(errors.Is(err, ErrInvalidDim))
+
+	cov, err = WeightedCov(nil, Rows, rowWeights)
+	assert.Nil(cov)
+	assert.True(errors.Is(err, ErrNilMatrix))
+}